@@ -0,0 +1,51 @@
+package calver
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrorClockBehind = errors.New("calver: Bump: the version is newer than the current date")
+	ErrorNoCounter   = errors.New("calver: Bump: the version already has the current date and its scheme has no counter")
+)
+
+// Bump returns the version that follows v when it's released at now, which is usually time.Now(), but can be any
+// clock so that the result is predictable. v isn't modified.
+//
+// If now is in a later period than v, the date segments are set from now, and the counters are reset to zero.
+// Otherwise, if v has a modifier, the modifier is removed, as in `2024.10.0-rc.1` to `2024.10.0`, and if it doesn't,
+// the last counter in the scheme is incremented.
+func (v *Version) Bump(now time.Time) (*Version, error) {
+	next := &Version{Scheme: v.Scheme, Year: now.Year()}
+
+	if v.Scheme.has(Segment.isWeek) {
+		next.Year, next.Week = now.ISOWeek()
+	}
+	if v.Scheme.has(Segment.isMonth) {
+		next.Month = int(now.Month())
+	}
+	if v.Scheme.has(Segment.isDay) {
+		next.Day = now.Day()
+	}
+
+	switch c := next.compareDate(v); {
+	case c < 0:
+		return nil, ErrorClockBehind
+	case c > 0:
+		return next, nil
+	}
+
+	next.Major, next.Minor, next.Micro = v.Major, v.Minor, v.Micro
+	if v.Modifier != "" {
+		return next, nil
+	}
+
+	for i := len(v.Scheme.Segments) - 1; i >= 0; i -= 1 {
+		if segment := v.Scheme.Segments[i]; segment.isCounter() {
+			*next.field(segment) += 1
+			return next, nil
+		}
+	}
+	return nil, ErrorNoCounter
+}
@@ -0,0 +1,48 @@
+package calver
+
+import (
+	"testing"
+	"time"
+)
+
+func TestVersion_Bump(t *testing.T) {
+	now := time.Date(2024, time.October, 3, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		scheme  string
+		args    string
+		now     time.Time
+		want    string
+		wantErr error
+	}{
+		{scheme: "YYYY.MM.DD", args: "2024.10.2", now: now, want: "2024.10.3"},
+		{scheme: "YYYY.MM.DD.MICRO", args: "2024.10.2.4", now: now, want: "2024.10.3.0"},
+		{scheme: "YY.0M.MICRO", args: "24.10.0", now: now, want: "24.10.1"},
+		{scheme: "YY.0M.MICRO", args: "24.09.5-rc.1", now: now, want: "24.10.0"},
+		{scheme: "YY.0M.MICRO", args: "24.10.1-rc.1", now: now, want: "24.10.1"},
+		{scheme: "YYYY.MAJOR.MINOR", args: "2024.3.12", now: now, want: "2024.3.13"},
+		{scheme: "YYYY.0W", args: "2024.39", now: now, want: "2024.40"},
+		{scheme: "YYYY.0W", args: "2024.52", now: time.Date(2024, time.December, 31, 0, 0, 0, 0, time.UTC), want: "2025.01"},
+		{scheme: "YYYY.MM.DD", args: "2024.10.2-rc.1", now: time.Date(2024, time.October, 2, 0, 0, 0, 0, time.UTC), want: "2024.10.2"},
+
+		{scheme: "YYYY.MM.DD", args: "2024.10.3", now: now, wantErr: ErrorNoCounter},
+		{scheme: "YYYY.MM.DD", args: "2024.10.4", now: now, wantErr: ErrorClockBehind},
+		{scheme: "YY.0M.MICRO", args: "25.01.0", now: now, wantErr: ErrorClockBehind},
+	}
+	for _, tt := range tests {
+		t.Run(tt.scheme+" "+tt.args, func(t *testing.T) {
+			v := MustParseScheme(tt.scheme).MustParse(tt.args)
+			got, err := v.Bump(tt.now)
+			if err != tt.wantErr {
+				t.Errorf("Bump() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && got.String() != tt.want {
+				t.Errorf("Bump() = %v, want %v", got, tt.want)
+			}
+			if v.String() != tt.args {
+				t.Errorf("Bump() modified the version to %v", v)
+			}
+		})
+	}
+}
@@ -0,0 +1,275 @@
+// Package calver parses, validates and compares calendar versions, such as `2024.10.3` or `24.04.1`, following
+// schemes like `YYYY.MM.DD` and `YY.0M.MICRO` as described at https://calver.org.
+package calver
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/codemicro/go-semver/semver"
+)
+
+var (
+	ErrorEmptyVersion    = errors.New("calver: Parse: empty version")
+	ErrorSchemeMismatch  = errors.New("calver: Parse: version has a different number of segments to its scheme")
+	ErrorInvalidSegment  = errors.New("calver: Parse: segment is not a number or is padded incorrectly")
+	ErrorOutOfRange      = errors.New("calver: Parse: date is out of range")
+	ErrorInvalidModifier = errors.New("calver: Parse: invalid modifier")
+)
+
+// Version is a calendar version. Only the fields for the segments in Scheme are used, and the others are zero.
+type Version struct {
+	Scheme *Scheme
+	// Year is always the full year, even if the scheme uses a short one. If the scheme has a week, it's the ISO 8601
+	// year that the week is in, which differs from the calendar year for a few days around the new year.
+	Year                int
+	Month, Week, Day    int
+	Major, Minor, Micro int
+	// Modifier is an optional tag after a hyphen, eg `rc.1` in `2024.10.3-rc.1`. A version with a modifier comes
+	// before the same version without one, and modifiers are compared in the same way as semantic version
+	// pre-releases.
+	Modifier string
+}
+
+// Parse parses a version that follows the scheme.
+func (s *Scheme) Parse(in string) (*Version, error) {
+	in = strings.TrimSpace(in)
+	if in == "" {
+		return nil, ErrorEmptyVersion
+	}
+
+	v := &Version{Scheme: s}
+
+	if i := strings.IndexByte(in, '-'); i != -1 {
+		v.Modifier = in[i+1:]
+		in = in[:i]
+		if err := validateModifier(v.Modifier); err != nil {
+			return nil, err
+		}
+	}
+
+	parts := strings.Split(in, ".")
+	if len(parts) != len(s.Segments) {
+		return nil, ErrorSchemeMismatch
+	}
+
+	for i, segment := range s.Segments {
+		part := parts[i]
+		if part == "" || strings.Trim(part, "0123456789") != "" {
+			return nil, ErrorInvalidSegment
+		}
+		if segment.IsPadded() {
+			if len(part) < 2 || (len(part) > 2 && part[0] == '0') {
+				return nil, ErrorInvalidSegment
+			}
+		} else if len(part) > 1 && part[0] == '0' {
+			return nil, ErrorInvalidSegment
+		}
+
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, ErrorInvalidSegment
+		}
+		*v.field(segment) = n
+		if segment == ShortYear || segment == PaddedYear {
+			v.Year += 2000
+		}
+	}
+
+	if err := v.validateDate(); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// MustParse is the same as Parse, but panics if the version can't be parsed.
+func (s *Scheme) MustParse(in string) *Version {
+	v, err := s.Parse(in)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// ParseMultiple parses every version in rawVersions, stopping at the first one that can't be parsed.
+func (s *Scheme) ParseMultiple(rawVersions []string) (Slice, error) {
+	var x Slice
+	for _, rawVersion := range rawVersions {
+		parsedVersion, err := s.Parse(rawVersion)
+		if err != nil {
+			return nil, err
+		}
+		x = append(x, parsedVersion)
+	}
+	return x, nil
+}
+
+func validateModifier(in string) error {
+	for _, identifier := range strings.Split(in, ".") {
+		if identifier == "" {
+			return ErrorInvalidModifier
+		}
+		for _, char := range []byte(identifier) {
+			if !(('0' <= char && char <= '9') || ('a' <= char && char <= 'z') || ('A' <= char && char <= 'Z') ||
+				char == '-') {
+				return ErrorInvalidModifier
+			}
+		}
+		// numeric identifiers with leading zeros aren't valid semantic version pre-releases
+		if len(identifier) > 1 && identifier[0] == '0' && strings.Trim(identifier, "0123456789") == "" {
+			return ErrorInvalidModifier
+		}
+	}
+	return nil
+}
+
+// field returns the field of v that holds the value of segment.
+func (v *Version) field(segment Segment) *int {
+	switch {
+	case segment.isYear():
+		return &v.Year
+	case segment.isMonth():
+		return &v.Month
+	case segment.isWeek():
+		return &v.Week
+	case segment.isDay():
+		return &v.Day
+	case segment == Major:
+		return &v.Major
+	case segment == Minor:
+		return &v.Minor
+	default:
+		return &v.Micro
+	}
+}
+
+func (v *Version) validateDate() error {
+	switch {
+	case v.Year < 1:
+		return ErrorOutOfRange
+	case v.Scheme.has(Segment.isMonth) && (v.Month < 1 || v.Month > 12):
+		return ErrorOutOfRange
+	case v.Scheme.has(Segment.isDay) && (v.Day < 1 || v.Day > daysIn(time.Month(v.Month), v.Year)):
+		return ErrorOutOfRange
+	case v.Scheme.has(Segment.isWeek) && (v.Week < 1 || v.Week > weeksIn(v.Year)):
+		return ErrorOutOfRange
+	}
+	return nil
+}
+
+func daysIn(month time.Month, year int) int {
+	// the zeroth day of the next month is the last day of this one
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func weeksIn(year int) int {
+	// 28 December is always in the last ISO week of its year
+	_, week := time.Date(year, time.December, 28, 0, 0, 0, 0, time.UTC).ISOWeek()
+	return week
+}
+
+// ReleaseDate returns the start of the period the version was released in, in UTC. That's the first day of the year
+// or month if the scheme doesn't have a day, or the Monday of the week if it has a week.
+func (v *Version) ReleaseDate() time.Time {
+	if v.Scheme.has(Segment.isWeek) {
+		// 4 January is always in the first ISO week of its year
+		jan4 := time.Date(v.Year, time.January, 4, 0, 0, 0, 0, time.UTC)
+		monday := jan4.AddDate(0, 0, -((int(jan4.Weekday()) + 6) % 7))
+		return monday.AddDate(0, 0, (v.Week-1)*7)
+	}
+
+	month, day := time.Month(v.Month), v.Day
+	if month == 0 {
+		month = time.January
+	}
+	if day == 0 {
+		day = 1
+	}
+	return time.Date(v.Year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func (v *Version) String() string {
+	x := v.formatSegments()
+	if v.Modifier != "" {
+		x += "-" + v.Modifier
+	}
+	return x
+}
+
+// formatSegments returns the segments of the version, without the modifier.
+func (v *Version) formatSegments() string {
+	parts := make([]string, len(v.Scheme.Segments))
+	for i, segment := range v.Scheme.Segments {
+		n := *v.field(segment)
+		if segment == ShortYear || segment == PaddedYear {
+			n -= 2000
+		}
+		parts[i] = strconv.Itoa(n)
+		if segment.IsPadded() && n < 10 {
+			parts[i] = "0" + parts[i]
+		}
+	}
+
+	return strings.Join(parts, ".")
+}
+
+func compareInts(a, b int) int {
+	switch {
+	case a > b:
+		return 1
+	case a < b:
+		return -1
+	default:
+		return 0
+	}
+}
+
+// compareDate compares the date segments of two versions.
+func (v *Version) compareDate(vx *Version) int {
+	for _, x := range [][2]int{{v.Year, vx.Year}, {v.Month, vx.Month}, {v.Week, vx.Week}, {v.Day, vx.Day}} {
+		if c := compareInts(x[0], x[1]); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// CompareTo compares two versions, by date, then by counter, then by modifier. 1 is v > vx, -1 is v < vx, 0 is
+// v == vx
+func (v *Version) CompareTo(vx *Version) int {
+	if c := v.compareDate(vx); c != 0 {
+		return c
+	}
+	for _, x := range [][2]int{{v.Major, vx.Major}, {v.Minor, vx.Minor}, {v.Micro, vx.Micro}} {
+		if c := compareInts(x[0], x[1]); c != 0 {
+			return c
+		}
+	}
+
+	var a, b semver.Version
+	if v.Modifier != "" {
+		a.Prerelease = strings.Split(v.Modifier, ".")
+	}
+	if vx.Modifier != "" {
+		b.Prerelease = strings.Split(vx.Modifier, ".")
+	}
+	return a.CompareTo(&b)
+}
+
+// Slice is a sortable slice of versions.
+type Slice []*Version
+
+func (s Slice) Len() int {
+	return len(s)
+}
+
+func (s Slice) Less(i, j int) bool {
+	return s[i].CompareTo(s[j]) == -1
+}
+
+func (s Slice) Swap(i, j int) {
+	s[j], s[i] = s[i], s[j]
+}
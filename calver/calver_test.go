@@ -0,0 +1,140 @@
+package calver
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestScheme_Parse(t *testing.T) {
+	tests := []struct {
+		scheme  string
+		args    string
+		want    *Version
+		wantErr error
+	}{
+		{scheme: "YYYY.MM.DD", args: "2024.10.3", want: &Version{Year: 2024, Month: 10, Day: 3}},
+		{scheme: "YY.0M.MICRO", args: "24.04.1", want: &Version{Year: 2024, Month: 4, Micro: 1}},
+		{scheme: "YYYY.0W", args: "2020.53", want: &Version{Year: 2020, Week: 53}},
+		{scheme: "0Y.0M", args: "06.12", want: &Version{Year: 2006, Month: 12}},
+		{scheme: "0Y.0M", args: "106.12", want: &Version{Year: 2106, Month: 12}},
+		{scheme: "YY.MM", args: "0.1", want: &Version{Year: 2000, Month: 1}},
+		{scheme: "YYYY.0M.0D", args: "2024.02.29-rc.1", want: &Version{Year: 2024, Month: 2, Day: 29, Modifier: "rc.1"}},
+		{scheme: "YYYY.MAJOR.MINOR", args: " 2024.3.12 ", want: &Version{Year: 2024, Major: 3, Minor: 12}},
+
+		{scheme: "YYYY.MM.DD", args: "", wantErr: ErrorEmptyVersion},
+		{scheme: "YYYY.MM.DD", args: "2024.10", wantErr: ErrorSchemeMismatch},
+		{scheme: "YYYY.MM", args: "2024.10.3", wantErr: ErrorSchemeMismatch},
+		{scheme: "YYYY.MM", args: "2024.x", wantErr: ErrorInvalidSegment},
+		{scheme: "YYYY.MM", args: "2024.", wantErr: ErrorInvalidSegment},
+		{scheme: "YYYY.MM", args: "2024.04", wantErr: ErrorInvalidSegment},
+		{scheme: "YYYY.0M", args: "2024.4", wantErr: ErrorInvalidSegment},
+		{scheme: "YYYY.0M", args: "2024.004", wantErr: ErrorInvalidSegment},
+		{scheme: "YY.MM", args: "024.4", wantErr: ErrorInvalidSegment},
+		{scheme: "YYYY.MICRO", args: "2024.01", wantErr: ErrorInvalidSegment},
+		{scheme: "YYYY.MM", args: "2024.13", wantErr: ErrorOutOfRange},
+		{scheme: "YYYY.0M", args: "2024.00", wantErr: ErrorOutOfRange},
+		{scheme: "YYYY.MM.DD", args: "2023.2.29", wantErr: ErrorOutOfRange},
+		{scheme: "YYYY.0W", args: "2021.53", wantErr: ErrorOutOfRange},
+		{scheme: "YYYY", args: "0", wantErr: ErrorOutOfRange},
+		{scheme: "YYYY.MM", args: "2024.10-", wantErr: ErrorInvalidModifier},
+		{scheme: "YYYY.MM", args: "2024.10-rc..1", wantErr: ErrorInvalidModifier},
+		{scheme: "YYYY.MM", args: "2024.10-rc_1", wantErr: ErrorInvalidModifier},
+		{scheme: "YYYY.MM", args: "2024.10-rc.01", wantErr: ErrorInvalidModifier},
+	}
+	for _, tt := range tests {
+		t.Run(tt.scheme+" "+tt.args, func(t *testing.T) {
+			scheme := MustParseScheme(tt.scheme)
+			got, err := scheme.Parse(tt.args)
+			if err != tt.wantErr {
+				t.Errorf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.want != nil {
+				tt.want.Scheme = scheme
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse() got = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVersion_String(t *testing.T) {
+	tests := []struct {
+		scheme string
+		args   string
+	}{
+		{"YYYY.MM.DD", "2024.10.3"},
+		{"YY.0M.MICRO", "24.04.1"},
+		{"0Y.0W", "06.01"},
+		{"0Y.0M", "106.01"},
+		{"YYYY.0M.0D", "2024.02.29-rc.1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.scheme+" "+tt.args, func(t *testing.T) {
+			if got := MustParseScheme(tt.scheme).MustParse(tt.args).String(); got != tt.args {
+				t.Errorf("String() = %v, want %v", got, tt.args)
+			}
+		})
+	}
+}
+
+func TestVersion_ReleaseDate(t *testing.T) {
+	tests := []struct {
+		scheme string
+		args   string
+		want   time.Time
+	}{
+		{"YYYY.MM.DD", "2024.10.3", time.Date(2024, time.October, 3, 0, 0, 0, 0, time.UTC)},
+		{"YY.0M.MICRO", "24.04.1", time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC)},
+		{"YYYY.MAJOR", "2024.5", time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{"YYYY.0W", "2024.01", time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{"YYYY.0W", "2021.01", time.Date(2021, time.January, 4, 0, 0, 0, 0, time.UTC)},
+		{"YYYY.0W", "2020.53", time.Date(2020, time.December, 28, 0, 0, 0, 0, time.UTC)},
+		{"YYYY.0W", "2025.01", time.Date(2024, time.December, 30, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.scheme+" "+tt.args, func(t *testing.T) {
+			if got := MustParseScheme(tt.scheme).MustParse(tt.args).ReleaseDate(); !got.Equal(tt.want) {
+				t.Errorf("ReleaseDate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVersion_CompareTo(t *testing.T) {
+	// in ascending order
+	ordered := []string{"23.12.0", "24.01.0-dev", "24.01.0-rc.1", "24.01.0-rc.2", "24.01.0", "24.01.1", "24.01.10",
+		"24.10.0", "100.01.0"}
+
+	vs, err := MustParseScheme("YY.0M.MICRO").ParseMultiple(ordered)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, a := range vs {
+		for j, b := range vs {
+			want := 0
+			if i < j {
+				want = -1
+			} else if i > j {
+				want = 1
+			}
+			if got := a.CompareTo(b); got != want {
+				t.Errorf("Version(%s).CompareTo(%s) = %v, want %v", a, b, got, want)
+			}
+		}
+	}
+
+	got := make(Slice, len(vs))
+	copy(got, vs)
+	rand.New(rand.NewSource(1)).Shuffle(len(got), got.Swap)
+	sort.Sort(got)
+
+	if !reflect.DeepEqual(got, vs) {
+		t.Errorf("Sorted slice is %v, want %v", got, vs)
+	}
+}
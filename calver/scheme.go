@@ -0,0 +1,154 @@
+package calver
+
+import (
+	"errors"
+	"strings"
+)
+
+var (
+	ErrorEmptyScheme    = errors.New("calver: ParseScheme: empty scheme")
+	ErrorUnknownSegment = errors.New("calver: ParseScheme: unknown segment")
+	ErrorNoYear         = errors.New("calver: ParseScheme: scheme must have a year")
+	ErrorInvalidScheme  = errors.New("calver: ParseScheme: segments are repeated, out of order or can't be combined")
+)
+
+// Segment is one part of a versioning scheme, such as the year or a counter.
+type Segment string
+
+const (
+	// FullYear is the year, eg 2006 or 2016.
+	FullYear Segment = "YYYY"
+	// ShortYear is the year minus 2000, eg 6 or 16.
+	ShortYear Segment = "YY"
+	// PaddedYear is the year minus 2000, padded to two digits, eg 06 or 16.
+	PaddedYear Segment = "0Y"
+	// ShortMonth is the month, eg 1 or 11.
+	ShortMonth Segment = "MM"
+	// PaddedMonth is the month, padded to two digits, eg 01 or 11.
+	PaddedMonth Segment = "0M"
+	// ShortWeek is the ISO 8601 week, eg 1 or 33.
+	ShortWeek Segment = "WW"
+	// PaddedWeek is the ISO 8601 week, padded to two digits, eg 01 or 33.
+	PaddedWeek Segment = "0W"
+	// ShortDay is the day of the month, eg 1 or 31.
+	ShortDay Segment = "DD"
+	// PaddedDay is the day of the month, padded to two digits, eg 01 or 31.
+	PaddedDay Segment = "0D"
+	// Major, Minor and Micro are counters, which start at zero for each new date and count releases on the same date.
+	Major Segment = "MAJOR"
+	Minor Segment = "MINOR"
+	Micro Segment = "MICRO"
+)
+
+// rank is the position each kind of segment must be in, relative to the others. Segments with the same rank can't
+// be in the same scheme, and neither can weeks with months or days.
+var rank = map[Segment]int{
+	FullYear:    0,
+	ShortYear:   0,
+	PaddedYear:  0,
+	ShortMonth:  1,
+	PaddedMonth: 1,
+	ShortWeek:   1,
+	PaddedWeek:  1,
+	ShortDay:    2,
+	PaddedDay:   2,
+	Major:       3,
+	Minor:       4,
+	Micro:       5,
+}
+
+// IsPadded returns true if the segment is zero-padded to two digits.
+func (s Segment) IsPadded() bool {
+	return s == PaddedYear || s == PaddedMonth || s == PaddedWeek || s == PaddedDay
+}
+
+func (s Segment) isYear() bool {
+	return s == FullYear || s == ShortYear || s == PaddedYear
+}
+
+func (s Segment) isMonth() bool {
+	return s == ShortMonth || s == PaddedMonth
+}
+
+func (s Segment) isWeek() bool {
+	return s == ShortWeek || s == PaddedWeek
+}
+
+func (s Segment) isDay() bool {
+	return s == ShortDay || s == PaddedDay
+}
+
+func (s Segment) isCounter() bool {
+	return s == Major || s == Minor || s == Micro
+}
+
+// Scheme is a calendar versioning scheme, as described at https://calver.org, eg `YYYY.0M.MICRO`.
+type Scheme struct {
+	Segments []Segment
+}
+
+// ParseScheme parses a scheme made of segments separated by dots. A scheme must start with a year, and can then have
+// a month or a week, a day if it has a month, and then any of `MAJOR`, `MINOR` and `MICRO`, in that order.
+func ParseScheme(in string) (*Scheme, error) {
+	in = strings.TrimSpace(in)
+	if in == "" {
+		return nil, ErrorEmptyScheme
+	}
+
+	s := new(Scheme)
+	lastRank := -1
+	var hasWeek bool
+	for _, raw := range strings.Split(in, ".") {
+		segment := Segment(raw)
+		r, found := rank[segment]
+		if !found {
+			return nil, ErrorUnknownSegment
+		}
+
+		switch {
+		case lastRank == -1 && !segment.isYear():
+			return nil, ErrorNoYear
+		case r <= lastRank, hasWeek && segment.isDay(), segment.isDay() && lastRank != rank[ShortMonth]:
+			return nil, ErrorInvalidScheme
+		}
+
+		hasWeek = hasWeek || segment.isWeek()
+		lastRank = r
+		s.Segments = append(s.Segments, segment)
+	}
+
+	return s, nil
+}
+
+// MustParseScheme is the same as ParseScheme, but panics if the scheme can't be parsed.
+func MustParseScheme(in string) *Scheme {
+	s, err := ParseScheme(in)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// has returns true if the scheme has a segment that f returns true for.
+func (s *Scheme) has(f func(Segment) bool) bool {
+	for _, segment := range s.Segments {
+		if f(segment) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsSemverCompatible returns true if every version in the scheme is also a valid semantic version, once missing
+// segments are filled with zeros. That's the case for schemes with at most three segments, none of which are padded.
+func (s *Scheme) IsSemverCompatible() bool {
+	return len(s.Segments) <= 3 && !s.has(Segment.IsPadded)
+}
+
+func (s *Scheme) String() string {
+	x := make([]string, len(s.Segments))
+	for i, segment := range s.Segments {
+		x[i] = string(segment)
+	}
+	return strings.Join(x, ".")
+}
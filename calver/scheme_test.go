@@ -0,0 +1,61 @@
+package calver
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseScheme(t *testing.T) {
+	tests := []struct {
+		args    string
+		want    []Segment
+		wantErr error
+	}{
+		{args: "YYYY.MM.DD", want: []Segment{FullYear, ShortMonth, ShortDay}},
+		{args: "YY.0M.MICRO", want: []Segment{ShortYear, PaddedMonth, Micro}},
+		{args: "YYYY.0W", want: []Segment{FullYear, PaddedWeek}},
+		{args: "0Y.MAJOR.MINOR.MICRO", want: []Segment{PaddedYear, Major, Minor, Micro}},
+		{args: "YYYY", want: []Segment{FullYear}},
+
+		{args: "", wantErr: ErrorEmptyScheme},
+		{args: "YYYY.M", wantErr: ErrorUnknownSegment},
+		{args: "yyyy", wantErr: ErrorUnknownSegment},
+		{args: "YYYY..MM", wantErr: ErrorUnknownSegment},
+		{args: "MM.YYYY", wantErr: ErrorNoYear},
+		{args: "MAJOR.YY", wantErr: ErrorNoYear},
+		{args: "YYYY.YY", wantErr: ErrorInvalidScheme},
+		{args: "YYYY.DD", wantErr: ErrorInvalidScheme},
+		{args: "YYYY.DD.MM", wantErr: ErrorInvalidScheme},
+		{args: "YYYY.MM.0M", wantErr: ErrorInvalidScheme},
+		{args: "YYYY.MM.WW", wantErr: ErrorInvalidScheme},
+		{args: "YYYY.WW.DD", wantErr: ErrorInvalidScheme},
+		{args: "YYYY.MICRO.MINOR", wantErr: ErrorInvalidScheme},
+		{args: "YYYY.MICRO.MM", wantErr: ErrorInvalidScheme},
+	}
+	for _, tt := range tests {
+		t.Run(tt.args, func(t *testing.T) {
+			got, err := ParseScheme(tt.args)
+			if err != tt.wantErr {
+				t.Errorf("ParseScheme() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil {
+				if !reflect.DeepEqual(got.Segments, tt.want) {
+					t.Errorf("ParseScheme().Segments = %v, want %v", got.Segments, tt.want)
+				}
+				if got.String() != tt.args {
+					t.Errorf("ParseScheme().String() = %v, want %v", got, tt.args)
+				}
+			}
+		})
+	}
+}
+
+func TestScheme_IsSemverCompatible(t *testing.T) {
+	for x, want := range map[string]bool{"YYYY.MM.DD": true, "YY.MM.MICRO": true, "YYYY.WW": true, "YY.0M.MICRO": false,
+		"YYYY.MM.DD.MICRO": false} {
+		if got := MustParseScheme(x).IsSemverCompatible(); got != want {
+			t.Errorf("Scheme(%s).IsSemverCompatible() = %v, want %v", x, got, want)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package calver
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/codemicro/go-semver/semver"
+)
+
+var (
+	ErrorNotSemverCompatible = errors.New("calver: scheme is not compatible with semantic versions")
+	ErrorSemverBuild         = errors.New("calver: semantic versions with build metadata have no calendar version")
+)
+
+// ToSemver converts v to a semantic version, filling any missing segments with zeros, so `2024.10` with the scheme
+// `YYYY.MM` becomes `2024.10.0`. The modifier becomes the pre-release. Only versions whose scheme is
+// semver-compatible can be converted, and they sort in the same order as the semantic versions they convert to.
+func (v *Version) ToSemver() (*semver.Version, error) {
+	if !v.Scheme.IsSemverCompatible() {
+		return nil, ErrorNotSemverCompatible
+	}
+
+	parts := v.formatSegments()
+	for i := len(v.Scheme.Segments); i < 3; i += 1 {
+		parts += ".0"
+	}
+	if v.Modifier != "" {
+		parts += "-" + v.Modifier
+	}
+	return semver.Parse(parts)
+}
+
+// FromSemver converts a semantic version to a version with the scheme. This is the reverse of Version.ToSemver, so
+// the scheme must be semver-compatible, any parts of the version core that the scheme doesn't have must be zero and
+// the version can't have build metadata.
+func (s *Scheme) FromSemver(sv *semver.Version) (*Version, error) {
+	if !s.IsSemverCompatible() {
+		return nil, ErrorNotSemverCompatible
+	}
+	if len(sv.Build) != 0 {
+		return nil, ErrorSemverBuild
+	}
+
+	// the numbers are taken from String, as the fields don't hold numbers that are too large for an int
+	core, prerelease := sv.String(), ""
+	if i := strings.IndexByte(core, '-'); i != -1 {
+		core, prerelease = core[:i], core[i:]
+	}
+
+	parts := strings.Split(core, ".")
+	for _, n := range parts[len(s.Segments):] {
+		if n != "0" {
+			return nil, ErrorSchemeMismatch
+		}
+	}
+	return s.Parse(strings.Join(parts[:len(s.Segments)], ".") + prerelease)
+}
@@ -0,0 +1,86 @@
+package calver
+
+import (
+	"testing"
+
+	"github.com/codemicro/go-semver/semver"
+)
+
+func TestVersion_ToSemver(t *testing.T) {
+	tests := []struct {
+		scheme  string
+		args    string
+		want    string
+		wantErr error
+	}{
+		{scheme: "YYYY.MM.DD", args: "2024.10.3", want: "2024.10.3"},
+		{scheme: "YY.MM", args: "24.4", want: "24.4.0"},
+		{scheme: "YYYY", args: "2024-rc.1", want: "2024.0.0-rc.1"},
+		{scheme: "YYYY.WW.MICRO", args: "2024.40.2", want: "2024.40.2"},
+
+		{scheme: "YY.0M.MICRO", args: "24.04.1", wantErr: ErrorNotSemverCompatible},
+		{scheme: "YYYY.MM.DD.MICRO", args: "2024.10.3.1", wantErr: ErrorNotSemverCompatible},
+	}
+	for _, tt := range tests {
+		t.Run(tt.scheme+" "+tt.args, func(t *testing.T) {
+			got, err := MustParseScheme(tt.scheme).MustParse(tt.args).ToSemver()
+			if err != tt.wantErr {
+				t.Errorf("ToSemver() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && got.String() != tt.want {
+				t.Errorf("ToSemver() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScheme_FromSemver(t *testing.T) {
+	tests := []struct {
+		scheme  string
+		args    string
+		want    string
+		wantErr error
+	}{
+		{scheme: "YYYY.MM.DD", args: "2024.10.3", want: "2024.10.3"},
+		{scheme: "YY.MM", args: "24.4.0", want: "24.4"},
+		{scheme: "YYYY", args: "2024.0.0-rc.1", want: "2024-rc.1"},
+
+		{scheme: "YY.MM", args: "24.4.1", wantErr: ErrorSchemeMismatch},
+		{scheme: "YYYY.MM.DD", args: "2024.13.1", wantErr: ErrorOutOfRange},
+		{scheme: "YYYY.MM.MICRO", args: "2024.10.18446744073709551616", wantErr: ErrorInvalidSegment},
+		{scheme: "YY.MM", args: "24.4.18446744073709551616", wantErr: ErrorSchemeMismatch},
+		{scheme: "YYYY.MM.DD", args: "2024.10.3+build", wantErr: ErrorSemverBuild},
+		{scheme: "YY.0M.MICRO", args: "24.4.1", wantErr: ErrorNotSemverCompatible},
+	}
+	for _, tt := range tests {
+		t.Run(tt.scheme+" "+tt.args, func(t *testing.T) {
+			got, err := MustParseScheme(tt.scheme).FromSemver(semver.MustParse(tt.args))
+			if err != tt.wantErr {
+				t.Errorf("FromSemver() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && got.String() != tt.want {
+				t.Errorf("FromSemver() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVersion_ToSemverOrdering(t *testing.T) {
+	vs, err := MustParseScheme("YY.MM.MICRO").ParseMultiple([]string{"99.12.3", "100.1.0-rc.1", "100.1.0", "24.4.1",
+		"24.10.0", "24.4.1-dev"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, a := range vs {
+		for _, b := range vs {
+			sa, _ := a.ToSemver()
+			sb, _ := b.ToSemver()
+			if got, want := sa.CompareTo(sb), a.CompareTo(b); got != want {
+				t.Errorf("semver %s.CompareTo(%s) = %v, want %v", sa, sb, got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package debian
+
+// order returns the sort weight of a character in a non-digit part of a version. `~` sorts before everything, even
+// the end of the part, and letters sort before any other characters.
+func order(s string, i int) int {
+	if i >= len(s) {
+		return 0
+	}
+	switch char := s[i]; {
+	case isDigit(char):
+		return 0
+	case isLetter(char):
+		return int(char)
+	case char == '~':
+		return -1
+	default:
+		return int(char) + 256
+	}
+}
+
+// compareParts compares two upstream versions or revisions using the same algorithm as dpkg, where the strings are
+// split into alternating non-digit and digit parts that are compared lexically and numerically.
+func compareParts(a, b string) int {
+	var i, j int
+	for i < len(a) || j < len(b) {
+		// non-digit part
+		for (i < len(a) && !isDigit(a[i])) || (j < len(b) && !isDigit(b[j])) {
+			if ac, bc := order(a, i), order(b, j); ac != bc {
+				return sign(ac - bc)
+			}
+			i += 1
+			j += 1
+		}
+
+		// digit part, compared without leading zeros
+		for i < len(a) && a[i] == '0' {
+			i += 1
+		}
+		for j < len(b) && b[j] == '0' {
+			j += 1
+		}
+
+		var firstDiff int
+		for i < len(a) && isDigit(a[i]) && j < len(b) && isDigit(b[j]) {
+			if firstDiff == 0 {
+				firstDiff = int(a[i]) - int(b[j])
+			}
+			i += 1
+			j += 1
+		}
+
+		// a longer number is always larger
+		if i < len(a) && isDigit(a[i]) {
+			return 1
+		}
+		if j < len(b) && isDigit(b[j]) {
+			return -1
+		}
+		if firstDiff != 0 {
+			return sign(firstDiff)
+		}
+	}
+	return 0
+}
+
+func sign(x int) int {
+	switch {
+	case x > 0:
+		return 1
+	case x < 0:
+		return -1
+	default:
+		return 0
+	}
+}
+
+// CompareTo compares two versions in the same way as dpkg. 1 is v > vx, -1 is v < vx, 0 is v == vx
+func (v *Version) CompareTo(vx *Version) int {
+	if c := sign(v.Epoch - vx.Epoch); c != 0 {
+		return c
+	}
+	if c := compareParts(v.Upstream, vx.Upstream); c != 0 {
+		return c
+	}
+	return compareParts(v.Revision, vx.Revision)
+}
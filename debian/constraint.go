@@ -0,0 +1,79 @@
+package debian
+
+import "github.com/codemicro/go-semver/internal/constraint"
+
+var (
+	ErrorNoConstraint    = constraint.ErrorNoConstraint
+	ErrorEmptyComparator = constraint.ErrorEmptyComparator
+	ErrorUnknownOperator = constraint.ErrorUnknownOperator
+)
+
+// Constraint is a version range that can be checked against many versions, eg `>=1:2.30 <<1:2.31 || =2.17-1`.
+//
+// It uses the same operators as semver.Filter: `=`, `!=`, `<`, `<=`, `>`, `>=` and `||`. dpkg's own spellings of
+// `<<` and `>>` are accepted too, and mean `<` and `>`. Note that dpkg also accepts `<` and `>` as obsolete spellings
+// of `<=` and `>=`, which is not supported.
+type Constraint struct {
+	b *constraint.Bound
+}
+
+var scheme = constraint.Scheme{
+	Parse: func(in string) (interface{}, error) {
+		return Parse(in)
+	},
+	Compare: compareVersions,
+	Format: func(v interface{}) string {
+		return v.(*Version).String()
+	},
+}
+
+func compareVersions(v, bound interface{}) int {
+	return v.(*Version).CompareTo(bound.(*Version))
+}
+
+// ParseConstraint parses a version range.
+func ParseConstraint(in string) (*Constraint, error) {
+	b, err := scheme.ParseBound(in)
+	if err != nil {
+		return nil, err
+	}
+	return &Constraint{b: b}, nil
+}
+
+// MustParseConstraint is the same as ParseConstraint, but panics if the range can't be parsed.
+func MustParseConstraint(in string) *Constraint {
+	c, err := ParseConstraint(in)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// Check returns true if v is within the range.
+func (c *Constraint) Check(v *Version) bool {
+	return c.b.Check(v)
+}
+
+// Filter returns the versions in options that are within the range, in the same order. options is not modified.
+func (c *Constraint) Filter(options Slice) Slice {
+	var x Slice
+	for _, v := range options {
+		if c.Check(v) {
+			x = append(x, v)
+		}
+	}
+	return x
+}
+
+func (c *Constraint) String() string {
+	return c.b.String()
+}
+
+// Filter returns the versions in options that are within the range described by filter. options is not modified.
+func Filter(filter string, options Slice) (Slice, error) {
+	c, err := ParseConstraint(filter)
+	if err != nil {
+		return nil, err
+	}
+	return c.Filter(options), nil
+}
@@ -0,0 +1,80 @@
+package debian
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseConstraint(t *testing.T) {
+	tests := []struct {
+		args    string
+		want    string
+		wantErr error
+	}{
+		{args: ">=1:2.30 <<1:2.31", want: ">=1:2.30 <1:2.31"},
+		{args: ">> 1.0-1 || = 2.0", want: ">1.0-1 || 2.0"},
+		{args: "1.0 || !=2.0~rc1", want: "1.0 || !=2.0~rc1"},
+
+		{args: "", wantErr: ErrorNoConstraint},
+		{args: ">=1.0 ||", wantErr: ErrorNoConstraint},
+		{args: ">=", wantErr: ErrorEmptyComparator},
+		{args: "=>1.0", wantErr: ErrorUnknownOperator},
+		{args: ">=a1.0", wantErr: ErrorUpstreamMustStartWith},
+	}
+	for _, tt := range tests {
+		t.Run(tt.args, func(t *testing.T) {
+			got, err := ParseConstraint(tt.args)
+			if err != tt.wantErr {
+				t.Errorf("ParseConstraint() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && got.String() != tt.want {
+				t.Errorf("ParseConstraint().String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConstraint_Check(t *testing.T) {
+	tests := []struct {
+		constraint string
+		version    string
+		want       bool
+	}{
+		{">=2.30", "2.30-1ubuntu2", true},
+		{">=2.30-2", "2.30-1ubuntu2", false},
+		{"<<2.30", "2.30~rc1", true},
+		{"<2.30", "2.30", false},
+		{"<=2.30", "2.30", true},
+		{">1.0", "1:0.1", true},
+		{"!=1.0", "1.0-0", false},
+		{"=1.0 || =2.0", "2.0", true},
+		{">=1.0 <<2.0", "2.0~beta1", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.constraint+" "+tt.version, func(t *testing.T) {
+			if got := MustParseConstraint(tt.constraint).Check(MustParse(tt.version)); got != tt.want {
+				t.Errorf("Check() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilter(t *testing.T) {
+	options, _ := ParseMultiple([]string{"2.31-0ubuntu1", "2.30-1ubuntu2", "2.17-326.el7", "1:2.17"})
+	original := make(Slice, len(options))
+	copy(original, options)
+
+	got, err := Filter(">=2.30 <<3", options)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := Slice{options[0], options[1]}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Filter() = %v, want %v", got, want)
+	}
+	if !reflect.DeepEqual(options, original) {
+		t.Errorf("Filter() modified options to %v", options)
+	}
+}
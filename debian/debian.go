@@ -0,0 +1,135 @@
+// Package debian parses and compares Debian package versions, as used by dpkg and apt.
+package debian
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/codemicro/go-semver/internal/epoch"
+)
+
+var (
+	ErrorEmptyVersion          = errors.New("debian: Parse: empty version")
+	ErrorInvalidEpoch          = errors.New("debian: Parse: epoch must be a non-negative number")
+	ErrorEmptyUpstreamVersion  = errors.New("debian: Parse: empty upstream version")
+	ErrorEmptyRevision         = errors.New("debian: Parse: empty revision")
+	ErrorUpstreamMustStartWith = errors.New("debian: Parse: upstream version must start with a digit")
+	ErrorInvalidCharacter      = errors.New("debian: Parse: invalid character in version")
+)
+
+// Version is a Debian package version, in the form `[epoch:]upstream_version[-debian_revision]`, eg
+// `1:2.30-1ubuntu2`.
+type Version struct {
+	// Epoch is 0 unless specified.
+	Epoch int
+	// Upstream is the version of the original software, eg `2.30`.
+	Upstream string
+	// Revision is the version of the Debian package of it, eg `1ubuntu2`. It may be empty.
+	Revision string
+}
+
+// Parse parses a Debian package version.
+func Parse(in string) (*Version, error) {
+	in = strings.TrimSpace(in)
+	if in == "" {
+		return nil, ErrorEmptyVersion
+	}
+
+	v := new(Version)
+
+	var ok bool
+	if v.Epoch, in, ok = epoch.Cut(in); !ok {
+		return nil, ErrorInvalidEpoch
+	}
+
+	v.Upstream = in
+	if i := strings.LastIndexByte(in, '-'); i != -1 {
+		v.Upstream, v.Revision = in[:i], in[i+1:]
+		if v.Revision == "" {
+			return nil, ErrorEmptyRevision
+		}
+	}
+
+	if v.Upstream == "" {
+		return nil, ErrorEmptyUpstreamVersion
+	} else if !isDigit(v.Upstream[0]) {
+		return nil, ErrorUpstreamMustStartWith
+	}
+
+	for _, char := range []byte(v.Upstream) {
+		// colons and hyphens can only be left here if there's an epoch or revision before or after them
+		if !(isAlphanumeric(char) || strings.IndexByte(".+~-:", char) != -1) {
+			return nil, ErrorInvalidCharacter
+		}
+	}
+
+	for _, char := range []byte(v.Revision) {
+		if !(isAlphanumeric(char) || strings.IndexByte(".+~", char) != -1) {
+			return nil, ErrorInvalidCharacter
+		}
+	}
+
+	return v, nil
+}
+
+// MustParse is the same as Parse, but panics if the version can't be parsed.
+func MustParse(in string) *Version {
+	v, err := Parse(in)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// ParseMultiple parses every version in rawVersions, stopping at the first one that can't be parsed.
+func ParseMultiple(rawVersions []string) (Slice, error) {
+	var x Slice
+	for _, rawVersion := range rawVersions {
+		parsedVersion, err := Parse(rawVersion)
+		if err != nil {
+			return nil, err
+		}
+		x = append(x, parsedVersion)
+	}
+	return x, nil
+}
+
+func isDigit(char byte) bool {
+	return '0' <= char && char <= '9'
+}
+
+func isLetter(char byte) bool {
+	return ('a' <= char && char <= 'z') || ('A' <= char && char <= 'Z')
+}
+
+func isAlphanumeric(char byte) bool {
+	return isDigit(char) || isLetter(char)
+}
+
+func (v *Version) String() string {
+	var x string
+	if v.Epoch != 0 {
+		x = strconv.Itoa(v.Epoch) + ":"
+	}
+	x += v.Upstream
+	if v.Revision != "" {
+		x += "-" + v.Revision
+	}
+	return x
+}
+
+// Slice is a sortable slice of versions.
+type Slice []*Version
+
+func (s Slice) Len() int {
+	return len(s)
+}
+
+func (s Slice) Less(i, j int) bool {
+	return s[i].CompareTo(s[j]) == -1
+}
+
+func (s Slice) Swap(i, j int) {
+	s[j], s[i] = s[i], s[j]
+}
@@ -0,0 +1,58 @@
+package debian
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		args    string
+		want    *Version
+		wantErr error
+	}{
+		{args: "1:2.30-1ubuntu2", want: &Version{Epoch: 1, Upstream: "2.30", Revision: "1ubuntu2"}},
+		{args: "2.30", want: &Version{Upstream: "2.30"}},
+		{args: " 2.30-1 ", want: &Version{Upstream: "2.30", Revision: "1"}},
+		{args: "1.0~rc1+dfsg-2~bpo11+1", want: &Version{Upstream: "1.0~rc1+dfsg", Revision: "2~bpo11+1"}},
+		{args: "2.0-beta-3", want: &Version{Upstream: "2.0-beta", Revision: "3"}},
+		{args: "1:2:3-1", want: &Version{Epoch: 1, Upstream: "2:3", Revision: "1"}},
+		{args: "0:1.0", want: &Version{Upstream: "1.0"}},
+
+		{args: "", wantErr: ErrorEmptyVersion},
+		{args: "a:1.0", wantErr: ErrorInvalidEpoch},
+		{args: "-1:1.0", wantErr: ErrorInvalidEpoch},
+		{args: "+1:1.0", wantErr: ErrorInvalidEpoch},
+		{args: ":1.0", wantErr: ErrorInvalidEpoch},
+		{args: "1:", wantErr: ErrorEmptyUpstreamVersion},
+		{args: "-1", wantErr: ErrorEmptyUpstreamVersion},
+		{args: "1.0-", wantErr: ErrorEmptyRevision},
+		{args: "a1.0", wantErr: ErrorUpstreamMustStartWith},
+		{args: "1.0 2", wantErr: ErrorInvalidCharacter},
+		{args: "1.0_2", wantErr: ErrorInvalidCharacter},
+		{args: "1:1.0-1:2", wantErr: ErrorInvalidCharacter},
+	}
+	for _, tt := range tests {
+		t.Run(tt.args, func(t *testing.T) {
+			got, err := Parse(tt.args)
+			if err != tt.wantErr {
+				t.Errorf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVersion_String(t *testing.T) {
+	for _, x := range []string{"1:2.30-1ubuntu2", "2.30", "2.0-beta-3", "1.0~rc1+dfsg-2~bpo11+1"} {
+		if got := MustParse(x).String(); got != x {
+			t.Errorf("Version(%s).String() = %v, want %v", x, got, x)
+		}
+	}
+	if got := MustParse("0:1.0").String(); got != "1.0" {
+		t.Errorf("Version(0:1.0).String() = %v, want 1.0", got)
+	}
+}
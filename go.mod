@@ -1,3 +1,3 @@
 module github.com/codemicro/go-semver
 
-go 1.10
+go 1.20
@@ -0,0 +1,172 @@
+// Package constraint implements the range operators shared by the version schemes in this module that don't have
+// their own constraint syntax. A constraint is a set of comparators such as `>=1.0 <2.0`, which must all match, and
+// several sets can be joined with `||`, in which case any of them must match.
+//
+// It also parses the interval notation used by Maven and NuGet, eg `[1.0,2.0)`, which can be converted to sets.
+//
+// The package works on any version type by being given functions to parse and compare versions.
+package constraint
+
+import (
+	"errors"
+	"strings"
+)
+
+var (
+	ErrorNoConstraint    = errors.New("constraint: no constraint provided")
+	ErrorEmptyComparator = errors.New("constraint: operator without a version")
+	ErrorUnknownOperator = errors.New("constraint: unknown operator")
+)
+
+// Operator is a comparison between two versions.
+type Operator string
+
+const (
+	Equal              Operator = "="
+	NotEqual           Operator = "!="
+	LessThan           Operator = "<"
+	LessThanOrEqual    Operator = "<="
+	GreaterThan        Operator = ">"
+	GreaterThanOrEqual Operator = ">="
+)
+
+// aliases maps every accepted spelling of an operator to the operator. It's ordered so that no spelling comes after
+// another spelling that it starts with.
+var aliases = []struct {
+	spelling string
+	operator Operator
+}{
+	{"==", Equal},
+	{"!=", NotEqual},
+	{"<=", LessThanOrEqual},
+	{">=", GreaterThanOrEqual},
+	{"<<", LessThan},
+	{">>", GreaterThan},
+	{"=", Equal},
+	{"<", LessThan},
+	{">", GreaterThan},
+}
+
+// Matches returns true if the result of comparing a version to another, as returned by a CompareTo method, satisfies
+// the operator.
+func (o Operator) Matches(comparison int) bool {
+	switch o {
+	case Equal:
+		return comparison == 0
+	case NotEqual:
+		return comparison != 0
+	case LessThan:
+		return comparison < 0
+	case LessThanOrEqual:
+		return comparison <= 0
+	case GreaterThan:
+		return comparison > 0
+	case GreaterThanOrEqual:
+		return comparison >= 0
+	default:
+		return false
+	}
+}
+
+// Comparator compares a version against Version, which is whatever type the parse function given to Parse returns.
+type Comparator struct {
+	Operator Operator
+	Version  interface{}
+}
+
+// Set is a set of comparators that must all match.
+type Set []Comparator
+
+// Constraint is a set of comparator sets, any of which must match.
+type Constraint []Set
+
+// Parse parses a constraint, using parse to parse each version in it. Comparators are separated by spaces, and an
+// operator may be separated from its version by spaces too. `<<` and `>>` are accepted as spellings of `<` and `>`,
+// `==` as a spelling of `=`, and a version with no operator must match exactly.
+func Parse(in string, parse func(string) (interface{}, error)) (Constraint, error) {
+	if strings.TrimSpace(in) == "" {
+		return nil, ErrorNoConstraint
+	}
+
+	var c Constraint
+	for _, block := range strings.Split(in, "||") {
+		fields := strings.Fields(block)
+		if len(fields) == 0 {
+			return nil, ErrorNoConstraint
+		}
+
+		var set Set
+		for i := 0; i < len(fields); i += 1 {
+			field := fields[i]
+
+			operator := Equal
+			var spelling string
+			for _, alias := range aliases {
+				if strings.HasPrefix(field, alias.spelling) {
+					operator, spelling = alias.operator, alias.spelling
+					break
+				}
+			}
+
+			raw := field[len(spelling):]
+			if raw == "" {
+				// whitespace between an operator and its version, eg `>= 1.0`
+				if i+1 == len(fields) {
+					return nil, ErrorEmptyComparator
+				}
+				i += 1
+				raw = fields[i]
+			}
+
+			if strings.ContainsAny(raw[:1], "<>=!") {
+				return nil, ErrorUnknownOperator
+			}
+
+			v, err := parse(raw)
+			if err != nil {
+				return nil, err
+			}
+			set = append(set, Comparator{Operator: operator, Version: v})
+		}
+		c = append(c, set)
+	}
+
+	return c, nil
+}
+
+// Check returns true if v satisfies c. compare is given v and the version from each comparator, and should return 1
+// if v is greater, -1 if v is smaller or 0 if they're equal.
+func (c Constraint) Check(v interface{}, compare func(v, bound interface{}) int) bool {
+	for _, set := range c {
+		if set.check(v, compare) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s Set) check(v interface{}, compare func(v, bound interface{}) int) bool {
+	for _, comparator := range s {
+		if !comparator.Operator.Matches(compare(v, comparator.Version)) {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns c in its canonical form, using format to format each version, eg `>=1.0 <2.0 || 3.0`.
+func (c Constraint) String(format func(interface{}) string) string {
+	sets := make([]string, len(c))
+	for i, set := range c {
+		comparators := make([]string, len(set))
+		for j, comparator := range set {
+			var operator string
+			if comparator.Operator != Equal {
+				operator = string(comparator.Operator)
+			}
+			comparators[j] = operator + format(comparator.Version)
+		}
+		sets[i] = strings.Join(comparators, " ")
+	}
+	return strings.Join(sets, " || ")
+}
@@ -0,0 +1,96 @@
+package constraint
+
+import (
+	"strconv"
+	"testing"
+)
+
+func parseInt(in string) (interface{}, error) {
+	return strconv.Atoi(in)
+}
+
+func compareInts(v, bound interface{}) int {
+	a, b := v.(int), bound.(int)
+	switch {
+	case a > b:
+		return 1
+	case a < b:
+		return -1
+	default:
+		return 0
+	}
+}
+
+func formatInt(v interface{}) string {
+	return strconv.Itoa(v.(int))
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		args    string
+		want    string
+		wantErr error
+	}{
+		{args: ">=1 <5", want: ">=1 <5"},
+		{args: ">= 1 << 5", want: ">=1 <5"},
+		{args: ">>1 || ==3 || 4", want: ">1 || 3 || 4"},
+		{args: "!=2", want: "!=2"},
+		{args: "", wantErr: ErrorNoConstraint},
+		{args: "1 ||", wantErr: ErrorNoConstraint},
+		{args: ">=", wantErr: ErrorEmptyComparator},
+		{args: "=>1", wantErr: ErrorUnknownOperator},
+		{args: "<>1", wantErr: ErrorUnknownOperator},
+	}
+	for _, tt := range tests {
+		t.Run(tt.args, func(t *testing.T) {
+			got, err := Parse(tt.args, parseInt)
+			if err != tt.wantErr {
+				t.Errorf("Parse() error = %v, want %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && got.String(formatInt) != tt.want {
+				t.Errorf("Parse().String() = %v, want %v", got.String(formatInt), tt.want)
+			}
+		})
+	}
+
+	t.Run("Invalid version", func(t *testing.T) {
+		if _, err := Parse(">=a", parseInt); err == nil {
+			t.Errorf("Parse() error = <nil>, want error")
+		}
+	})
+}
+
+func TestConstraint_Check(t *testing.T) {
+	c, err := Parse(">=2 <5 !=3 || 7 || >9", parseInt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for v, want := range map[int]bool{1: false, 2: true, 3: false, 4: true, 5: false, 7: true, 8: false, 9: false, 10: true} {
+		if got := c.Check(v, compareInts); got != want {
+			t.Errorf("Constraint.Check(%d) = %v, want %v", v, got, want)
+		}
+	}
+}
+
+func TestScheme_ParseBound(t *testing.T) {
+	scheme := Scheme{Parse: parseInt, Compare: compareInts, Format: formatInt}
+
+	b, err := scheme.ParseBound(">= 2 << 5 || ==7")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := b.String(), ">=2 <5 || 7"; got != want {
+		t.Errorf("Bound.String() = %v, want %v", got, want)
+	}
+	for v, want := range map[int]bool{1: false, 2: true, 4: true, 5: false, 7: true} {
+		if got := b.Check(v); got != want {
+			t.Errorf("Bound.Check(%d) = %v, want %v", v, got, want)
+		}
+	}
+
+	if _, err := scheme.ParseBound(">="); err != ErrorEmptyComparator {
+		t.Errorf("Scheme.ParseBound() error = %v, want %v", err, ErrorEmptyComparator)
+	}
+}
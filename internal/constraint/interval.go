@@ -0,0 +1,151 @@
+package constraint
+
+import (
+	"errors"
+	"strings"
+)
+
+var (
+	ErrorInvalidInterval       = errors.New("constraint: invalid interval")
+	ErrorUnclosedInterval      = errors.New("constraint: interval is not closed")
+	ErrorSingleVersionInterval = errors.New("constraint: a single version must be surrounded by []")
+	ErrorIdenticalBounds       = errors.New("constraint: interval cannot have identical bounds")
+)
+
+// Interval is a range written in interval notation, eg `[1.0,2.0)`, where a square bracket means the bound is
+// included in the range and a parenthesis means it isn't. Lower and Upper are empty if there's no bound, as in
+// `(,2.0)`. `[1.0]` matches only 1.0, and has identical, inclusive bounds.
+type Interval struct {
+	Lower, Upper                   string
+	LowerInclusive, UpperInclusive bool
+}
+
+// ParseIntervals parses a comma-separated list of intervals, eg `(,1.0],[1.2,)`. Whitespace around bounds and
+// intervals is ignored.
+func ParseIntervals(in string) ([]Interval, error) {
+	in = strings.TrimSpace(in)
+	if in == "" {
+		return nil, ErrorNoConstraint
+	}
+
+	var x []Interval
+	for in != "" {
+		if in[0] != '[' && in[0] != '(' {
+			return nil, ErrorInvalidInterval
+		}
+
+		end := strings.IndexAny(in, ")]")
+		if end == -1 {
+			return nil, ErrorUnclosedInterval
+		}
+
+		interval, err := parseInterval(in[:end+1])
+		if err != nil {
+			return nil, err
+		}
+		x = append(x, interval)
+
+		in = strings.TrimSpace(in[end+1:])
+		if strings.HasPrefix(in, ",") {
+			in = strings.TrimSpace(in[1:])
+			if in == "" {
+				return nil, ErrorInvalidInterval
+			}
+		} else if in != "" {
+			return nil, ErrorInvalidInterval
+		}
+	}
+
+	return x, nil
+}
+
+func parseInterval(in string) (Interval, error) {
+	interval := Interval{
+		LowerInclusive: in[0] == '[',
+		UpperInclusive: in[len(in)-1] == ']',
+	}
+
+	body := strings.TrimSpace(in[1 : len(in)-1])
+	if strings.ContainsAny(body, "[(") {
+		return Interval{}, ErrorUnclosedInterval
+	}
+
+	parts := strings.Split(body, ",")
+	switch len(parts) {
+	case 1:
+		if !interval.LowerInclusive || !interval.UpperInclusive {
+			return Interval{}, ErrorSingleVersionInterval
+		}
+		if body == "" {
+			return Interval{}, ErrorInvalidInterval
+		}
+		interval.Lower, interval.Upper = body, body
+	case 2:
+		interval.Lower, interval.Upper = strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+		if interval.Lower == interval.Upper {
+			return Interval{}, ErrorIdenticalBounds
+		}
+	default:
+		return Interval{}, ErrorInvalidInterval
+	}
+
+	return interval, nil
+}
+
+// IsExact returns true if the interval only matches a single version, as in `[1.0]`.
+func (i Interval) IsExact() bool {
+	return i.Lower != "" && i.Lower == i.Upper
+}
+
+// Set returns the comparators that make up the interval, using parse to parse each bound. An interval with no
+// bounds returns an empty set, which matches everything.
+func (i Interval) Set(parse func(string) (interface{}, error)) (Set, error) {
+	if i.IsExact() {
+		v, err := parse(i.Lower)
+		if err != nil {
+			return nil, err
+		}
+		return Set{{Operator: Equal, Version: v}}, nil
+	}
+
+	var set Set
+	if i.Lower != "" {
+		v, err := parse(i.Lower)
+		if err != nil {
+			return nil, err
+		}
+		operator := GreaterThan
+		if i.LowerInclusive {
+			operator = GreaterThanOrEqual
+		}
+		set = append(set, Comparator{Operator: operator, Version: v})
+	}
+	if i.Upper != "" {
+		v, err := parse(i.Upper)
+		if err != nil {
+			return nil, err
+		}
+		operator := LessThan
+		if i.UpperInclusive {
+			operator = LessThanOrEqual
+		}
+		set = append(set, Comparator{Operator: operator, Version: v})
+	}
+	return set, nil
+}
+
+func (i Interval) String() string {
+	x := "("
+	if i.LowerInclusive {
+		x = "["
+	}
+	if i.IsExact() {
+		x += i.Lower
+	} else {
+		x += i.Lower + "," + i.Upper
+	}
+	if i.UpperInclusive {
+		return x + "]"
+	}
+	return x + ")"
+}
@@ -0,0 +1,76 @@
+package constraint
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseIntervals(t *testing.T) {
+	tests := []struct {
+		args    string
+		want    []Interval
+		wantErr error
+	}{
+		{args: "[1,2)", want: []Interval{{Lower: "1", Upper: "2", LowerInclusive: true}}},
+		{args: " ( , 2 ] ", want: []Interval{{Upper: "2", UpperInclusive: true}}},
+		{args: "[3]", want: []Interval{{Lower: "3", Upper: "3", LowerInclusive: true, UpperInclusive: true}}},
+		{args: "(,1],[2,)", want: []Interval{{Upper: "1", UpperInclusive: true}, {Lower: "2", LowerInclusive: true}}},
+		{args: "[1,2] , (3,4)", want: []Interval{{Lower: "1", Upper: "2", LowerInclusive: true, UpperInclusive: true}, {Lower: "3", Upper: "4"}}},
+
+		{args: "", wantErr: ErrorNoConstraint},
+		{args: "1", wantErr: ErrorInvalidInterval},
+		{args: "[1,2", wantErr: ErrorUnclosedInterval},
+		{args: "[1,[2]", wantErr: ErrorUnclosedInterval},
+		{args: "(1)", wantErr: ErrorSingleVersionInterval},
+		{args: "[1)", wantErr: ErrorSingleVersionInterval},
+		{args: "[]", wantErr: ErrorInvalidInterval},
+		{args: "(,)", wantErr: ErrorIdenticalBounds},
+		{args: "[1,1]", wantErr: ErrorIdenticalBounds},
+		{args: "[1,2,3]", wantErr: ErrorInvalidInterval},
+		{args: "[1,2],", wantErr: ErrorInvalidInterval},
+		{args: "[1,2] 3", wantErr: ErrorInvalidInterval},
+	}
+	for _, tt := range tests {
+		t.Run(tt.args, func(t *testing.T) {
+			got, err := ParseIntervals(tt.args)
+			if err != tt.wantErr {
+				t.Errorf("ParseIntervals() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseIntervals() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInterval_Set(t *testing.T) {
+	tests := []struct {
+		args string
+		want string
+	}{
+		{args: "[1,2)", want: ">=1 <2"},
+		{args: "(1,2]", want: ">1 <=2"},
+		{args: "(,2)", want: "<2"},
+		{args: "[1,)", want: ">=1"},
+		{args: "[3]", want: "3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.args, func(t *testing.T) {
+			intervals, err := ParseIntervals(tt.args)
+			if err != nil {
+				t.Fatal(err)
+			}
+			set, err := intervals[0].Set(parseInt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := (Constraint{set}).String(formatInt); got != tt.want {
+				t.Errorf("Set() = %v, want %v", got, tt.want)
+			}
+			if got := intervals[0].String(); got != tt.args {
+				t.Errorf("String() = %v, want %v", got, tt.args)
+			}
+		})
+	}
+}
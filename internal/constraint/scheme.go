@@ -0,0 +1,37 @@
+package constraint
+
+// Scheme is the functions a version type provides to use Constraint, for packages whose constraints are nothing more
+// than Parse, Check and String with their own version type.
+type Scheme struct {
+	// Parse parses a version, and is given to Parse.
+	Parse func(string) (interface{}, error)
+	// Compare is given to Constraint.Check.
+	Compare func(v, bound interface{}) int
+	// Format is given to Constraint.String.
+	Format func(interface{}) string
+}
+
+// Bound is a Constraint together with the Scheme that it was parsed with, so that it can be checked and formatted
+// without being given the scheme's functions again.
+type Bound struct {
+	scheme Scheme
+	c      Constraint
+}
+
+// ParseBound parses a constraint using the scheme's Parse function.
+func (s Scheme) ParseBound(in string) (*Bound, error) {
+	c, err := Parse(in, s.Parse)
+	if err != nil {
+		return nil, err
+	}
+	return &Bound{scheme: s, c: c}, nil
+}
+
+// Check returns true if v satisfies the constraint.
+func (b *Bound) Check(v interface{}) bool {
+	return b.c.Check(v, b.scheme.Compare)
+}
+
+func (b *Bound) String() string {
+	return b.c.String(b.scheme.Format)
+}
@@ -0,0 +1,30 @@
+// Package epoch parses the epoch at the start of Debian and RPM package versions, eg the 1 in `1:2.30-1`.
+package epoch
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Cut splits the epoch from the start of a package version, at the first colon. If there isn't a colon, the epoch is
+// 0 and rest is the whole of in. ok is false if what comes before the colon isn't a non-negative decimal number that
+// fits in an int. Unlike strconv.Atoi, signs such as the `+` in `+1:2.30` aren't accepted.
+func Cut(in string) (n int, rest string, ok bool) {
+	i := strings.IndexByte(in, ':')
+	if i == -1 {
+		return 0, in, true
+	}
+
+	for _, char := range []byte(in[:i]) {
+		if !('0' <= char && char <= '9') {
+			return 0, "", false
+		}
+	}
+
+	// this can only fail if there are no digits or the number doesn't fit in an int
+	n, err := strconv.Atoi(in[:i])
+	if err != nil {
+		return 0, "", false
+	}
+	return n, in[i+1:], true
+}
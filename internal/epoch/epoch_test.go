@@ -0,0 +1,31 @@
+package epoch
+
+import "testing"
+
+func TestCut(t *testing.T) {
+	tests := []struct {
+		args     string
+		wantN    int
+		wantRest string
+		wantOk   bool
+	}{
+		{args: "2.30-1", wantN: 0, wantRest: "2.30-1", wantOk: true},
+		{args: "1:2.30-1", wantN: 1, wantRest: "2.30-1", wantOk: true},
+		{args: "01:2.30", wantN: 1, wantRest: "2.30", wantOk: true},
+		{args: "1:2:3", wantN: 1, wantRest: "2:3", wantOk: true},
+		{args: ":1.0"},
+		{args: "a:1.0"},
+		{args: "-1:1.0"},
+		{args: "+1:1.0"},
+		{args: " 1:1.0"},
+		{args: "99999999999999999999:1.0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.args, func(t *testing.T) {
+			n, rest, ok := Cut(tt.args)
+			if n != tt.wantN || rest != tt.wantRest || ok != tt.wantOk {
+				t.Errorf("Cut() = %v, %v, %v, want %v, %v, %v", n, rest, ok, tt.wantN, tt.wantRest, tt.wantOk)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package maven
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// orderedVersions are in ascending order, taken from VERSIONS_QUALIFIER and VERSIONS_NUMBER in the tests of
+// ComparableVersion in Maven 3.9
+var orderedVersions = [][]string{
+	{"1-alpha2snapshot", "1-alpha2", "1-alpha-123", "1-beta-2", "1-beta123", "1-m2", "1-m11", "1-rc", "1-cr2",
+		"1-rc123", "1-SNAPSHOT", "1", "1-sp", "1-sp2", "1-sp123", "1-abc", "1-def", "1-pom-1", "1-1-snapshot", "1-1",
+		"1-2", "1-123"},
+	{"2.0", "2.0.a", "2-1", "2.0.2", "2.0.123", "2.1.0", "2.1-a", "2.1b", "2.1-c", "2.1-1", "2.1.0.1", "2.2", "2.123",
+		"11.a2", "11.a11", "11.b2", "11.b11", "11.m2", "11.m11", "11", "11.a", "11b", "11c", "11m"},
+}
+
+func TestVersion_CompareTo(t *testing.T) {
+	for _, ordered := range orderedVersions {
+		vs, err := ParseMultiple(ordered)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for i, a := range vs {
+			for j, b := range vs {
+				want := 0
+				if i < j {
+					want = -1
+				} else if i > j {
+					want = 1
+				}
+				if got := a.CompareTo(b); got != want {
+					t.Errorf("Version(%s).CompareTo(%s) = %v, want %v", a, b, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestVersion_CompareToLess(t *testing.T) {
+	// taken from the tests of ComparableVersion in Maven 3.9
+	tests := [][2]string{
+		{"1", "2"}, {"1.5", "2"}, {"1", "2.5"}, {"1.0", "1.1"}, {"1.1", "1.2"}, {"1.0.0", "1.1"}, {"1.0.1", "1.1"},
+		{"1.1", "1.2.0"}, {"1.0-alpha-1", "1.0"}, {"1.0-alpha-1", "1.0-alpha-2"}, {"1.0-alpha-1", "1.0-beta-1"},
+		{"1.0-beta-1", "1.0-SNAPSHOT"}, {"1.0-SNAPSHOT", "1.0"}, {"1.0-alpha-1-SNAPSHOT", "1.0-alpha-1"},
+		{"1.0", "1.0-1"}, {"1.0-1", "1.0-2"}, {"1.0.0", "1.0-1"}, {"2.0-1", "2.0.1"}, {"2.0.1-klm", "2.0.1-lmn"},
+		{"2.0.1", "2.0.1-xyz"}, {"2.0.1", "2.0.1-123"}, {"2.0.1-xyz", "2.0.1-123"},
+
+		// leading zeros
+		{"0.7", "2"}, {"0.2", "1.0.7"},
+
+		// MNG-5568
+		{"6.1.0rc3", "6.1.0"}, {"6.1.0rc3", "6.1H.5-beta"}, {"6.1.0", "6.1H.5-beta"},
+
+		// MNG-6572
+		{"20190126.230843", "1234567890.12345"}, {"1234567890.12345", "123456789012345.1H.5-beta"},
+		{"20190126.230843", "123456789012345.1H.5-beta"}, {"123456789012345.1H.5-beta", "12345678901234567890.1H.5-beta"},
+		{"1234567890.12345", "12345678901234567890.1H.5-beta"}, {"20190126.230843", "12345678901234567890.1H.5-beta"},
+
+		// MNG-6964
+		{"1-0.alpha", "1"}, {"1-0.beta", "1"}, {"1-0.alpha", "1-0.beta"},
+	}
+
+	// MNG-7644
+	for _, x := range []string{"abc", "alpha", "a", "beta", "b", "def", "milestone", "m", "RC"} {
+		tests = append(tests, [2]string{"1.0.0." + x + "1", "1.0.0-" + x + "2"})
+	}
+
+	for _, tt := range tests {
+		a, b := MustParse(tt[0]), MustParse(tt[1])
+		if got := a.CompareTo(b); got != -1 {
+			t.Errorf("Version(%s).CompareTo(%s) = %v, want -1", a, b, got)
+		}
+		if got := b.CompareTo(a); got != 1 {
+			t.Errorf("Version(%s).CompareTo(%s) = %v, want 1", b, a, got)
+		}
+	}
+}
+
+func TestVersion_CompareToEqual(t *testing.T) {
+	// taken from the tests of ComparableVersion in Maven 3.9. Each pair also has the same canonical form.
+	tests := [][2]string{
+		{"1", "1"}, {"1", "1.0"}, {"1", "1.0.0"}, {"1.0", "1.0.0"}, {"1", "1-0"}, {"1", "1.0-0"}, {"1.0", "1.0-0"},
+		{"1a", "1-a"}, {"1a", "1.0-a"}, {"1a", "1.0.0-a"}, {"1.0a", "1-a"}, {"1.0.0a", "1-a"}, {"1x", "1-x"},
+		{"1x", "1.0-x"}, {"1x", "1.0.0-x"}, {"1.0x", "1-x"}, {"1.0.0x", "1-x"}, {"1cr", "1rc"}, {"1a1", "1-alpha-1"},
+		{"1b2", "1-beta-2"}, {"1m3", "1-milestone-3"}, {"1X", "1x"}, {"1A", "1a"}, {"1B", "1b"}, {"1M", "1m"},
+		{"1Cr", "1Rc"}, {"1cR", "1rC"}, {"1m3", "1Milestone3"}, {"1m3", "1MileStone3"}, {"1m3", "1MILESTONE3"},
+		{"1-99999999999999999999", "1-099999999999999999999"},
+
+		// leading zeros
+		{"0000000000000000001", "1"}, {"000000001", "1"}, {"0000000000000000000", "0"}, {"000000000", "0"},
+	}
+
+	// MNG-7644
+	for _, x := range []string{"abc", "alpha", "a", "beta", "b", "def", "milestone", "m", "RC"} {
+		tests = append(tests, [2]string{"2-" + x, "2.0." + x}, [2]string{"2-" + x, "2.0.0." + x},
+			[2]string{"2.0." + x, "2.0.0." + x})
+	}
+
+	for _, tt := range tests {
+		a, b := MustParse(tt[0]), MustParse(tt[1])
+		if got := a.CompareTo(b); got != 0 {
+			t.Errorf("Version(%s).CompareTo(%s) = %v, want 0", a, b, got)
+		}
+		if a.Canonical() != b.Canonical() {
+			t.Errorf("Version(%s).Canonical() = %v, want %v", a, a.Canonical(), b.Canonical())
+		}
+	}
+}
+
+func TestVersion_CompareToSameOrder(t *testing.T) {
+	// taken from the tests of ComparableVersion in Maven 3.9, where these are ordered the same but aren't equal
+	for _, x := range []string{"1ga", "1release", "1final", "1Ga", "1GA", "1RELEASE", "1RELeaSE", "1Final", "1FinaL",
+		"1FINAL"} {
+		a, b := MustParse(x), MustParse("1")
+		if got := a.CompareTo(b); got != 0 {
+			t.Errorf("Version(%s).CompareTo(%s) = %v, want 0", a, b, got)
+		}
+		if a.Canonical() == b.Canonical() {
+			t.Errorf("Version(%s).Canonical() = %v, want it to differ", a, a.Canonical())
+		}
+	}
+}
+
+func TestSliceSort(t *testing.T) {
+	want, _ := ParseMultiple(orderedVersions[1])
+
+	got := make(Slice, len(want))
+	copy(got, want)
+	rand.New(rand.NewSource(1)).Shuffle(len(got), got.Swap)
+	sort.Sort(got)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Sorted slice is %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,132 @@
+package maven
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/codemicro/go-semver/internal/constraint"
+)
+
+var (
+	ErrorNoConstraint        = constraint.ErrorNoConstraint
+	ErrorInvalidRange        = constraint.ErrorInvalidInterval
+	ErrorUnclosedRange       = constraint.ErrorUnclosedInterval
+	ErrorSingleVersionRange  = constraint.ErrorSingleVersionInterval
+	ErrorIdenticalBounds     = constraint.ErrorIdenticalBounds
+	ErrorRangeDefiesOrdering = errors.New("maven: ParseConstraint: range has a lower bound greater than its upper bound")
+	ErrorRangesOverlap       = errors.New("maven: ParseConstraint: ranges overlap or are out of order")
+)
+
+// Constraint is a Maven version range, eg `[1.0,2.0)`, `(,1.5]` or `(,1.0],[1.2,)`.
+//
+// Square brackets include a bound and parentheses exclude it, `[1.0]` matches only 1.0, and several ranges can be
+// given in ascending order, separated by commas, in which case a version must be in any of them. Maven treats a
+// version on its own, eg `1.0`, as a soft requirement that any version can override, but here it only matches itself,
+// so that it's useful for filtering.
+type Constraint struct {
+	raw string
+	c   constraint.Constraint
+}
+
+func parseVersion(in string) (interface{}, error) {
+	return Parse(in)
+}
+
+func compareVersions(v, bound interface{}) int {
+	return v.(*Version).CompareTo(bound.(*Version))
+}
+
+// ParseConstraint parses a version range.
+func ParseConstraint(in string) (*Constraint, error) {
+	in = strings.TrimSpace(in)
+	if in == "" {
+		return nil, ErrorNoConstraint
+	}
+
+	if in[0] != '[' && in[0] != '(' {
+		v, err := Parse(in)
+		if err != nil {
+			return nil, err
+		}
+		return &Constraint{
+			raw: v.String(),
+			c:   constraint.Constraint{{{Operator: constraint.Equal, Version: v}}},
+		}, nil
+	}
+
+	intervals, err := constraint.ParseIntervals(in)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Constraint{}
+	var ranges []string
+	var previousUpper *Version
+	for i, interval := range intervals {
+		set, err := interval.Set(parseVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		var lower, upper *Version
+		if interval.Lower != "" {
+			lower = set[0].Version.(*Version)
+		}
+		if interval.Upper != "" {
+			upper = set[len(set)-1].Version.(*Version)
+		}
+
+		if lower != nil && upper != nil && lower.CompareTo(upper) == 1 {
+			return nil, ErrorRangeDefiesOrdering
+		}
+		if i != 0 && (previousUpper == nil || lower == nil || lower.CompareTo(previousUpper) == -1) {
+			return nil, ErrorRangesOverlap
+		}
+		previousUpper = upper
+
+		c.c = append(c.c, set)
+		ranges = append(ranges, interval.String())
+	}
+	c.raw = strings.Join(ranges, ",")
+
+	return c, nil
+}
+
+// MustParseConstraint is the same as ParseConstraint, but panics if the range can't be parsed.
+func MustParseConstraint(in string) *Constraint {
+	c, err := ParseConstraint(in)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// Check returns true if v is within the range.
+func (c *Constraint) Check(v *Version) bool {
+	return c.c.Check(v, compareVersions)
+}
+
+// Filter returns the versions in options that are within the range, in the same order. options is not modified.
+func (c *Constraint) Filter(options Slice) Slice {
+	var x Slice
+	for _, v := range options {
+		if c.Check(v) {
+			x = append(x, v)
+		}
+	}
+	return x
+}
+
+// String returns the range without any whitespace, eg `[1.0,2.0)`.
+func (c *Constraint) String() string {
+	return c.raw
+}
+
+// Filter returns the versions in options that are within the range described by filter. options is not modified.
+func Filter(filter string, options Slice) (Slice, error) {
+	c, err := ParseConstraint(filter)
+	if err != nil {
+		return nil, err
+	}
+	return c.Filter(options), nil
+}
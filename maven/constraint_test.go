@@ -0,0 +1,91 @@
+package maven
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseConstraint(t *testing.T) {
+	tests := []struct {
+		args    string
+		want    string
+		wantErr error
+	}{
+		{args: "[1.0,2.0)", want: "[1.0,2.0)"},
+		{args: "( , 1.5 ]", want: "(,1.5]"},
+		{args: "[1.0]", want: "[1.0]"},
+		{args: "(,1.0], [1.2,)", want: "(,1.0],[1.2,)"},
+		{args: "1.0-SNAPSHOT", want: "1.0-SNAPSHOT"},
+
+		{args: "", wantErr: ErrorNoConstraint},
+		{args: "[1.0,2.0", wantErr: ErrorUnclosedRange},
+		{args: "(1.0)", wantErr: ErrorSingleVersionRange},
+		{args: "[1.0,1.0]", wantErr: ErrorIdenticalBounds},
+		{args: "[1.0,2.0) 3.0", wantErr: ErrorInvalidRange},
+		{args: "[2.0,1.0]", wantErr: ErrorRangeDefiesOrdering},
+		{args: "[1.0,2.0],[1.5,3.0]", wantErr: ErrorRangesOverlap},
+		{args: "[1.0,),[1.5,3.0]", wantErr: ErrorRangesOverlap},
+		{args: "[1.5,3.0],(,1.0]", wantErr: ErrorRangesOverlap},
+	}
+	for _, tt := range tests {
+		t.Run(tt.args, func(t *testing.T) {
+			got, err := ParseConstraint(tt.args)
+			if err != tt.wantErr {
+				t.Errorf("ParseConstraint() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && got.String() != tt.want {
+				t.Errorf("ParseConstraint().String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConstraint_Check(t *testing.T) {
+	tests := []struct {
+		constraint string
+		version    string
+		want       bool
+	}{
+		{"[1.0,2.0)", "1.0", true},
+		{"[1.0,2.0)", "1.5.3", true},
+		{"[1.0,2.0)", "2.0", false},
+		{"[1.0,2.0)", "2.0-SNAPSHOT", true},
+		{"(1.0,2.0]", "1.0.0", false},
+		{"(1.0,2.0]", "2.0.0", true},
+		{"(,1.5]", "1.5-rc1", true},
+		{"(,1.5]", "1.5-sp1", false},
+		{"[1.0]", "1", true},
+		{"[1.0]", "1.0.1", false},
+		{"(,1.0],[1.2,)", "1.1", false},
+		{"(,1.0],[1.2,)", "1.2.1", true},
+		{"1.0", "1.0.0", true},
+		{"1.0", "1.1", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.constraint+" "+tt.version, func(t *testing.T) {
+			if got := MustParseConstraint(tt.constraint).Check(MustParse(tt.version)); got != tt.want {
+				t.Errorf("Check() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilter(t *testing.T) {
+	options, _ := ParseMultiple([]string{"2.1", "1.0-SNAPSHOT", "1.0", "1.9.9", "2.0"})
+	original := make(Slice, len(options))
+	copy(original, options)
+
+	got, err := Filter("[1.0,2.0)", options)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := Slice{options[2], options[3]}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Filter() = %v, want %v", got, want)
+	}
+	if !reflect.DeepEqual(options, original) {
+		t.Errorf("Filter() modified options to %v", options)
+	}
+}
@@ -0,0 +1,264 @@
+package maven
+
+import (
+	"strconv"
+	"strings"
+)
+
+// item is part of a version. compareTo is given nil when the other version has no item in the same place.
+type item interface {
+	compareTo(other item) int
+	isNull() bool
+	String() string
+}
+
+// intItem is a number, without leading zeros so that numbers of any size can be compared.
+type intItem string
+
+// stringItem is a qualifier, eg `alpha` or `snapshot`.
+type stringItem string
+
+// combinationItem is a qualifier followed by a number, eg `rc1` or `rc-1`.
+type combinationItem struct {
+	qualifier stringItem
+	number    intItem
+}
+
+// listItem is a list of items, started by a hyphen or a change between digits and letters.
+type listItem struct {
+	items []item
+}
+
+// qualifiers are the qualifiers that Maven knows, in order. The empty string is a release, and any other qualifier
+// comes after all of them, in alphabetical order.
+var qualifiers = []string{"alpha", "beta", "milestone", "rc", "snapshot", "", "sp"}
+
+var releaseQualifier = comparableQualifier("")
+
+// releaseQualifiers are ordered in the same place as a release, but unlike an alias they aren't replaced, so `1-ga` and
+// `1` have different canonical forms.
+var releaseQualifiers = []string{"ga", "final", "release"}
+
+var qualifierAliases = map[string]string{
+	"cr": "rc",
+}
+
+func newStringItem(value string, followedByDigit bool) stringItem {
+	if followedByDigit && len(value) == 1 {
+		switch value {
+		case "a":
+			value = "alpha"
+		case "b":
+			value = "beta"
+		case "m":
+			value = "milestone"
+		}
+	}
+	if alias, found := qualifierAliases[value]; found {
+		value = alias
+	}
+	return stringItem(value)
+}
+
+func comparableQualifier(qualifier string) string {
+	for _, q := range releaseQualifiers {
+		if q == qualifier {
+			qualifier = ""
+		}
+	}
+	for i, q := range qualifiers {
+		if q == qualifier {
+			return strconv.Itoa(i)
+		}
+	}
+	return strconv.Itoa(len(qualifiers)) + "-" + qualifier
+}
+
+func (i intItem) compareTo(other item) int {
+	switch other := other.(type) {
+	case nil:
+		if i.isNull() {
+			return 0
+		}
+		return 1
+	case intItem:
+		if len(i) != len(other) {
+			if len(i) > len(other) {
+				return 1
+			}
+			return -1
+		}
+		return strings.Compare(string(i), string(other))
+	default:
+		// 1.1 > 1-sp, 1.1 > 1-sp1 and 1.1 > 1-1
+		return 1
+	}
+}
+
+func (i intItem) isNull() bool {
+	return i == ""
+}
+
+func (i intItem) String() string {
+	if i == "" {
+		return "0"
+	}
+	return string(i)
+}
+
+func (s stringItem) compareTo(other item) int {
+	switch other := other.(type) {
+	case nil:
+		// 1-rc < 1, 1-ga == 1 and 1-sp > 1
+		return strings.Compare(comparableQualifier(string(s)), releaseQualifier)
+	case stringItem:
+		return strings.Compare(comparableQualifier(string(s)), comparableQualifier(string(other)))
+	case combinationItem:
+		// 1-rc < 1-rc1
+		if c := s.compareTo(other.qualifier); c != 0 {
+			return c
+		}
+		return -1
+	default:
+		// 1.any < 1.1 and 1.any < 1-1
+		return -1
+	}
+}
+
+func (s stringItem) isNull() bool {
+	return s == ""
+}
+
+func (s stringItem) String() string {
+	return string(s)
+}
+
+// newCombinationItem splits a qualifier followed by a number, eg `rc1`, into its two parts.
+func newCombinationItem(value string) combinationItem {
+	i := strings.IndexAny(value, "0123456789")
+	return combinationItem{qualifier: newStringItem(value[:i], true), number: intItem(strings.TrimLeft(value[i:], "0"))}
+}
+
+func (c combinationItem) compareTo(other item) int {
+	switch other := other.(type) {
+	case nil:
+		// 1-rc1 < 1 and 1-sp1 > 1
+		return c.qualifier.compareTo(nil)
+	case stringItem:
+		// 1-rc1 > 1-rc
+		if n := c.qualifier.compareTo(other); n != 0 {
+			return n
+		}
+		return 1
+	case combinationItem:
+		if n := c.qualifier.compareTo(other.qualifier); n != 0 {
+			return n
+		}
+		return c.number.compareTo(other.number)
+	default:
+		// 1-sp1 < 1.1 and 1-sp1 < 1-1
+		return -1
+	}
+}
+
+func (c combinationItem) isNull() bool {
+	return false
+}
+
+func (c combinationItem) String() string {
+	return c.qualifier.String() + c.number.String()
+}
+
+func (l *listItem) compareTo(other item) int {
+	switch other := other.(type) {
+	case nil:
+		// every item is compared, so that 1-0.alpha < 1
+		for _, x := range l.items {
+			if c := x.compareTo(nil); c != 0 {
+				return c
+			}
+		}
+		return 0
+	case intItem:
+		// 1-1 < 1.0.x
+		return -1
+	case stringItem, combinationItem:
+		// 1-1 > 1-sp and 1-1 > 1-sp1
+		return 1
+	case *listItem:
+		for i := 0; i < len(l.items) || i < len(other.items); i += 1 {
+			var left, right item
+			if i < len(l.items) {
+				left = l.items[i]
+			}
+			if i < len(other.items) {
+				right = other.items[i]
+			}
+
+			var c int
+			if left == nil {
+				c = -right.compareTo(nil)
+			} else {
+				c = left.compareTo(right)
+			}
+			if c != 0 {
+				return c
+			}
+		}
+		return 0
+	default:
+		return 0
+	}
+}
+
+func (l *listItem) isNull() bool {
+	return len(l.items) == 0
+}
+
+// normalise removes null items, such as the zeros in `1.0.0`, that are at the end or are followed by a qualifier or by
+// a sub-list that starts with one, so that `1.0.0-rc1` is the same as `1-rc1`.
+func (l *listItem) normalise() {
+	for i := len(l.items) - 1; i >= 0; i -= 1 {
+		if !l.items[i].isNull() {
+			continue
+		}
+
+		remove := i == len(l.items)-1
+		if !remove {
+			switch next := l.items[i+1].(type) {
+			case stringItem:
+				remove = true
+			case *listItem:
+				remove = len(next.items) != 0 && isQualifier(next.items[0])
+			}
+		}
+		if remove {
+			l.items = append(l.items[:i], l.items[i+1:]...)
+		}
+	}
+}
+
+// isQualifier returns true if x is a qualifier, with or without a number after it.
+func isQualifier(x item) bool {
+	switch x.(type) {
+	case stringItem, combinationItem:
+		return true
+	default:
+		return false
+	}
+}
+
+func (l *listItem) String() string {
+	var sb strings.Builder
+	for _, x := range l.items {
+		if sb.Len() != 0 {
+			if _, isList := x.(*listItem); isList {
+				sb.WriteByte('-')
+			} else {
+				sb.WriteByte('.')
+			}
+		}
+		sb.WriteString(x.String())
+	}
+	return sb.String()
+}
@@ -0,0 +1,182 @@
+// Package maven parses and compares Maven artifact versions, using the same ordering as ComparableVersion in Maven
+// 3.9.
+package maven
+
+import (
+	"errors"
+	"strings"
+)
+
+var ErrorEmptyVersion = errors.New("maven: Parse: empty version")
+
+// Version is a Maven artifact version, eg `1.2.3`, `1.0-SNAPSHOT` or `2.0.0-RC1`.
+//
+// Maven accepts any string as a version, and orders them by splitting them into numbers and qualifiers, so the only
+// string that can't be parsed is an empty one.
+type Version struct {
+	original string
+	items    *listItem
+}
+
+// Parse parses a Maven version.
+func Parse(in string) (*Version, error) {
+	in = strings.TrimSpace(in)
+	if in == "" {
+		return nil, ErrorEmptyVersion
+	}
+	return &Version{original: in, items: parseItems(strings.ToLower(in))}, nil
+}
+
+// MustParse is the same as Parse, but panics if the version can't be parsed.
+func MustParse(in string) *Version {
+	v, err := Parse(in)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// ParseMultiple parses every version in rawVersions, stopping at the first one that can't be parsed.
+func ParseMultiple(rawVersions []string) (Slice, error) {
+	var x Slice
+	for _, rawVersion := range rawVersions {
+		parsedVersion, err := Parse(rawVersion)
+		if err != nil {
+			return nil, err
+		}
+		x = append(x, parsedVersion)
+	}
+	return x, nil
+}
+
+// parseItems splits a lowercase version into items in the same way as ComparableVersion.parseVersion in Maven 3.9.
+// Dots separate items, while hyphens and changes between digits and letters start a new sub-list. A qualifier
+// followed by a number, eg `rc1` or `rc-1`, is a single combination item, and a qualifier at the end after a dot is
+// treated as if it came after a hyphen, so that `1.0.0.x` is the same as `1-x`.
+func parseItems(in string) *listItem {
+	list := new(listItem)
+	root := list
+	stack := []*listItem{list}
+
+	newSubList := func() {
+		sub := new(listItem)
+		list.items = append(list.items, sub)
+		list = sub
+		stack = append(stack, sub)
+	}
+
+	var isDigit, isCombination bool
+	var start int
+	for i := 0; i < len(in); i += 1 {
+		char := in[i]
+		switch {
+		case char == '.':
+			if i == start {
+				list.items = append(list.items, intItem(""))
+			} else {
+				list.items = append(list.items, parseItem(isCombination, isDigit, in[start:i]))
+			}
+			isCombination = false
+			start = i + 1
+		case char == '-':
+			if i == start {
+				list.items = append(list.items, intItem(""))
+			} else {
+				if !isDigit && i+1 < len(in) && isASCIIDigit(in[i+1]) {
+					// `x-1` is the same as `x1`
+					isCombination = true
+					continue
+				}
+				list.items = append(list.items, parseItem(isCombination, isDigit, in[start:i]))
+			}
+			start = i + 1
+			if len(list.items) != 0 {
+				newSubList()
+			}
+			isCombination = false
+		case isASCIIDigit(char):
+			if !isDigit && i > start {
+				// eg the x1 in `1.0.0.x1`, which goes in a new sub-list
+				isCombination = true
+				if len(list.items) != 0 {
+					newSubList()
+				}
+			}
+			isDigit = true
+		default:
+			if isDigit && i > start {
+				list.items = append(list.items, parseItem(isCombination, true, in[start:i]))
+				start = i
+				newSubList()
+				isCombination = false
+			}
+			isDigit = false
+		}
+	}
+
+	if len(in) > start {
+		// `1.0.0.x1` < `1.0.0-x2`, as a qualifier after a dot is treated as if it came after a hyphen
+		if !isDigit && len(list.items) != 0 {
+			newSubList()
+		}
+		list.items = append(list.items, parseItem(isCombination, isDigit, in[start:]))
+	}
+
+	for i := len(stack) - 1; i >= 0; i -= 1 {
+		stack[i].normalise()
+	}
+
+	return root
+}
+
+func isASCIIDigit(char byte) bool {
+	return '0' <= char && char <= '9'
+}
+
+func parseItem(isCombination, isDigit bool, in string) item {
+	switch {
+	case isCombination:
+		return newCombinationItem(strings.Replace(in, "-", "", -1))
+	case isDigit:
+		return intItem(strings.TrimLeft(in, "0"))
+	default:
+		return newStringItem(in, false)
+	}
+}
+
+// String returns the version as it was parsed.
+func (v *Version) String() string {
+	return v.original
+}
+
+// Canonical returns the canonical form of the version, eg `1-snapshot` for `1.0-SNAPSHOT`. Two versions with the same
+// canonical form are equal, but as in Maven, versions such as `1-ga` and `1` compare as equal while keeping different
+// canonical forms.
+func (v *Version) Canonical() string {
+	return v.items.String()
+}
+
+// IsSnapshot returns true if the version is a development version, ending in `-SNAPSHOT`.
+func (v *Version) IsSnapshot() bool {
+	return strings.HasSuffix(strings.ToLower(v.original), "-snapshot")
+}
+
+// CompareTo compares two versions in the same way as Maven. 1 is v > vx, -1 is v < vx, 0 is v == vx
+func (v *Version) CompareTo(vx *Version) int {
+	return v.items.compareTo(vx.items)
+}
+
+// Slice is a sortable slice of versions.
+type Slice []*Version
+
+func (s Slice) Len() int {
+	return len(s)
+}
+
+func (s Slice) Less(i, j int) bool {
+	return s[i].CompareTo(s[j]) == -1
+}
+
+func (s Slice) Swap(i, j int) {
+	s[j], s[i] = s[i], s[j]
+}
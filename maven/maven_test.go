@@ -0,0 +1,55 @@
+package maven
+
+import "testing"
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		args          string
+		wantCanonical string
+		wantErr       error
+	}{
+		{args: "1.2.3", wantCanonical: "1.2.3"},
+		{args: "1.0.0", wantCanonical: "1"},
+		{args: "1.0-SNAPSHOT", wantCanonical: "1-snapshot"},
+		{args: "2.0.0-RC1", wantCanonical: "2-rc1"},
+		{args: "1.0-ga", wantCanonical: "1-ga"},
+		{args: "1.0.0.x1", wantCanonical: "1-x1"},
+		{args: "1a2", wantCanonical: "1-alpha2"},
+		{args: "1-cr2", wantCanonical: "1-rc2"},
+		{args: "0.x", wantCanonical: "x"},
+		{args: "0.2", wantCanonical: "0.2"},
+		{args: "0-1", wantCanonical: "0-1"},
+		{args: "1..2", wantCanonical: "1.0.2"},
+		{args: "007", wantCanonical: "7"},
+		{args: " 1.0 ", wantCanonical: "1"},
+
+		{args: "", wantErr: ErrorEmptyVersion},
+		{args: "  ", wantErr: ErrorEmptyVersion},
+	}
+	for _, tt := range tests {
+		t.Run(tt.args, func(t *testing.T) {
+			got, err := Parse(tt.args)
+			if err != tt.wantErr {
+				t.Errorf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && got.Canonical() != tt.wantCanonical {
+				t.Errorf("Parse().Canonical() = %v, want %v", got.Canonical(), tt.wantCanonical)
+			}
+		})
+	}
+}
+
+func TestVersion_String(t *testing.T) {
+	if got := MustParse(" 1.0-SNAPSHOT ").String(); got != "1.0-SNAPSHOT" {
+		t.Errorf("String() = %v, want 1.0-SNAPSHOT", got)
+	}
+}
+
+func TestVersion_IsSnapshot(t *testing.T) {
+	for x, want := range map[string]bool{"1.0-SNAPSHOT": true, "1.0-snapshot": true, "1.0": false, "1.0-SNAPSHOT-1": false} {
+		if got := MustParse(x).IsSnapshot(); got != want {
+			t.Errorf("Version(%s).IsSnapshot() = %v, want %v", x, got, want)
+		}
+	}
+}
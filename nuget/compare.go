@@ -0,0 +1,69 @@
+package nuget
+
+import "strings"
+
+func compareInts(a, b int) int {
+	switch {
+	case a > b:
+		return 1
+	case a < b:
+		return -1
+	default:
+		return 0
+	}
+}
+
+// comparePrerelease compares pre-release labels in the same way as NuGet. Numeric identifiers are compared
+// numerically and come before alphanumeric ones, which are compared case-insensitively.
+func comparePrerelease(a, b []string) int {
+	if len(a) == 0 || len(b) == 0 {
+		// a release comes after any pre-release
+		return compareInts(len(b), len(a))
+	}
+
+	for i := 0; i < len(a) && i < len(b); i += 1 {
+		aNumeric, bNumeric := isNumeric(a[i]), isNumeric(b[i])
+		var c int
+		switch {
+		case aNumeric && bNumeric:
+			// leading zeros aren't allowed, so the longer number is larger
+			c = compareInts(len(a[i]), len(b[i]))
+			if c == 0 {
+				c = strings.Compare(a[i], b[i])
+			}
+		case aNumeric:
+			c = -1
+		case bNumeric:
+			c = 1
+		default:
+			c = strings.Compare(strings.ToLower(a[i]), strings.ToLower(b[i]))
+		}
+		if c != 0 {
+			return c
+		}
+	}
+
+	return compareInts(len(a), len(b))
+}
+
+// CompareTo compares two versions in the same way as NuGet, ignoring metadata. 1 is v > vx, -1 is v < vx, 0 is
+// v == vx
+func (v *Version) CompareTo(vx *Version) int {
+	if c := v.compareNumbers(vx); c != 0 {
+		return c
+	}
+	return comparePrerelease(v.Prerelease, vx.Prerelease)
+}
+
+func (v *Version) compareNumbers(vx *Version) int {
+	if c := compareInts(v.Major, vx.Major); c != 0 {
+		return c
+	}
+	if c := compareInts(v.Minor, vx.Minor); c != 0 {
+		return c
+	}
+	if c := compareInts(v.Patch, vx.Patch); c != 0 {
+		return c
+	}
+	return compareInts(v.Revision, vx.Revision)
+}
@@ -0,0 +1,58 @@
+package nuget
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// orderedVersions is in ascending order
+var orderedVersions = []string{
+	"0.9", "1.0.0-0", "1.0.0-2", "1.0.0-10", "1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-BETA",
+	"1.0.0-beta.2", "1.0.0-beta.11", "1.0.0-rc.1", "1.0", "1.0.0.1-alpha", "1.0.0.1", "1.0.1", "1.2.3.4", "1.10",
+	"2.0.0-RC1", "2.0.0",
+}
+
+func TestVersion_CompareTo(t *testing.T) {
+	vs, err := ParseMultiple(orderedVersions)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, a := range vs {
+		for j, b := range vs {
+			want := 0
+			if i < j {
+				want = -1
+			} else if i > j {
+				want = 1
+			}
+			if got := a.CompareTo(b); got != want {
+				t.Errorf("Version(%s).CompareTo(%s) = %v, want %v", a, b, got, want)
+			}
+		}
+	}
+}
+
+func TestVersion_CompareToEqual(t *testing.T) {
+	for _, x := range [][2]string{{"1.0", "1.0.0.0"}, {"1", "1.0.0"}, {"1.0-BETA", "1.0-beta"}, {"1.0+a", "1.0+b"},
+		{"01.0", "1.0"}} {
+		if got := MustParse(x[0]).CompareTo(MustParse(x[1])); got != 0 {
+			t.Errorf("Version(%s).CompareTo(%s) = %v, want 0", x[0], x[1], got)
+		}
+	}
+}
+
+func TestSliceSort(t *testing.T) {
+	want, _ := ParseMultiple(orderedVersions)
+
+	got := make(Slice, len(want))
+	copy(got, want)
+	rand.New(rand.NewSource(1)).Shuffle(len(got), got.Swap)
+	sort.Sort(got)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Sorted slice is %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,199 @@
+package nuget
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/codemicro/go-semver/internal/constraint"
+)
+
+var (
+	ErrorNoConstraint        = constraint.ErrorNoConstraint
+	ErrorInvalidRange        = constraint.ErrorInvalidInterval
+	ErrorUnclosedRange       = constraint.ErrorUnclosedInterval
+	ErrorSingleVersionRange  = constraint.ErrorSingleVersionInterval
+	ErrorIdenticalBounds     = constraint.ErrorIdenticalBounds
+	ErrorRangeDefiesOrdering = errors.New("nuget: ParseConstraint: range has a lower bound greater than its upper bound")
+	ErrorFloatingUpperBound  = errors.New("nuget: ParseConstraint: only the lower bound of a range can float")
+)
+
+// Constraint is a NuGet version range, eg `1.0`, `[1.0,2.0)`, `(,1.5]` or `1.*`.
+//
+// Square brackets include a bound and parentheses exclude it, `[1.0]` matches only 1.0, and a version on its own is a
+// minimum, so `1.0` matches 1.0 and anything newer. The lower bound can be a floating version, eg `1.*`, `1.2.*-*` or
+// `1.0.0-beta*`. As in NuGet, a floating version is the same as its lowest match for Check and Filter, so `1.*` is
+// the same as `1.0`, and only changes which version FindBestMatch picks. Pre-releases aren't treated specially, so
+// `1.0` matches 1.1.0-beta.
+type Constraint struct {
+	interval constraint.Interval
+	min, max *Version
+	float    *floatRange
+	c        constraint.Constraint
+}
+
+func compareVersions(v, bound interface{}) int {
+	return v.(*Version).CompareTo(bound.(*Version))
+}
+
+// ParseConstraint parses a version range.
+func ParseConstraint(in string) (*Constraint, error) {
+	in = strings.TrimSpace(in)
+	if in == "" {
+		return nil, ErrorNoConstraint
+	}
+
+	var interval constraint.Interval
+	if in[0] != '[' && in[0] != '(' {
+		interval = constraint.Interval{Lower: in, LowerInclusive: true}
+	} else {
+		intervals, err := constraint.ParseIntervals(in)
+		if err != nil {
+			return nil, err
+		}
+		if len(intervals) != 1 {
+			return nil, ErrorInvalidRange
+		}
+		interval = intervals[0]
+	}
+
+	if isFloat(interval.Upper) {
+		return nil, ErrorFloatingUpperBound
+	}
+
+	c := &Constraint{interval: interval}
+
+	parse := func(in string) (interface{}, error) {
+		if !isFloat(in) {
+			return Parse(in)
+		}
+		f, err := parseFloat(in)
+		if err != nil {
+			return nil, err
+		}
+		c.float = f
+		return f.min, nil
+	}
+
+	set, err := interval.Set(parse)
+	if err != nil {
+		return nil, err
+	}
+	if interval.Lower != "" {
+		c.min = set[0].Version.(*Version)
+	}
+	if interval.Upper != "" {
+		c.max = set[len(set)-1].Version.(*Version)
+	}
+
+	if c.min != nil && c.max != nil && !interval.IsExact() {
+		if n := c.min.CompareTo(c.max); n == 1 || (n == 0 && !(interval.LowerInclusive && interval.UpperInclusive)) {
+			return nil, ErrorRangeDefiesOrdering
+		}
+	}
+
+	c.c = constraint.Constraint{set}
+	return c, nil
+}
+
+// MustParseConstraint is the same as ParseConstraint, but panics if the range can't be parsed.
+func MustParseConstraint(in string) *Constraint {
+	c, err := ParseConstraint(in)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// IsFloating returns true if the lower bound of the range is a floating version, eg `1.*`.
+func (c *Constraint) IsFloating() bool {
+	return c.float != nil
+}
+
+// Check returns true if v is within the range.
+func (c *Constraint) Check(v *Version) bool {
+	return c.c.Check(v, compareVersions)
+}
+
+// Filter returns the versions in options that are within the range, in the same order. options is not modified.
+func (c *Constraint) Filter(options Slice) Slice {
+	var x Slice
+	for _, v := range options {
+		if c.Check(v) {
+			x = append(x, v)
+		}
+	}
+	return x
+}
+
+// FindBestMatch returns the version in options that NuGet would pick for the range, or nil if none of them are
+// within it. That's the lowest version in the range, unless the range floats, in which case it's the highest version
+// that matches the floating version.
+func (c *Constraint) FindBestMatch(options Slice) *Version {
+	var best *Version
+	for _, v := range options {
+		if c.Check(v) && (best == nil || c.isBetter(best, v)) {
+			best = v
+		}
+	}
+	return best
+}
+
+// isBetter returns true if considering is a better match than current, using the same rules as NuGet's
+// VersionRange.IsBetter.
+func (c *Constraint) isBetter(current, considering *Version) bool {
+	if c.float != nil {
+		currentFloats, consideringFloats := c.float.satisfies(current), c.float.satisfies(considering)
+		switch {
+		case currentFloats && consideringFloats:
+			// prefer the highest version that matches the floating version
+			return current.CompareTo(considering) == -1
+		case currentFloats || consideringFloats:
+			return consideringFloats
+		}
+
+		// when neither match, prefer versions above the floating version, and then the closest one to it
+		currentBelow, consideringBelow := current.CompareTo(c.float.min) == -1, considering.CompareTo(c.float.min) == -1
+		switch {
+		case currentBelow && consideringBelow:
+			return current.CompareTo(considering) == -1
+		case currentBelow || consideringBelow:
+			return currentBelow
+		}
+	}
+
+	return current.CompareTo(considering) == 1
+}
+
+// String returns the normalised form of the range, eg `[1.0.0, 2.0.0)` for `[1.0,2.0)` and `[1.0.0, )` for `1.0`.
+func (c *Constraint) String() string {
+	if c.interval.IsExact() {
+		return "[" + c.min.String() + "]"
+	}
+
+	x := "("
+	if c.interval.LowerInclusive {
+		x = "["
+	}
+	if c.float != nil {
+		x += c.float.String()
+	} else if c.min != nil {
+		x += c.min.String()
+	}
+	x += ", "
+	if c.max != nil {
+		x += c.max.String()
+	}
+	if c.interval.UpperInclusive {
+		return x + "]"
+	}
+	return x + ")"
+}
+
+// Filter returns the versions in options that are within the range described by filter. options is not modified.
+func Filter(filter string, options Slice) (Slice, error) {
+	c, err := ParseConstraint(filter)
+	if err != nil {
+		return nil, err
+	}
+	return c.Filter(options), nil
+}
@@ -0,0 +1,137 @@
+package nuget
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseConstraint(t *testing.T) {
+	tests := []struct {
+		args    string
+		want    string
+		wantErr error
+	}{
+		{args: "1.0", want: "[1.0.0, )"},
+		{args: "[1.0,2.0)", want: "[1.0.0, 2.0.0)"},
+		{args: "( , 1.5 ]", want: "(, 1.5.0]"},
+		{args: "[1.0]", want: "[1.0.0]"},
+		{args: "[1.0, 1.0.0.0]", want: "[1.0.0, 1.0.0]"},
+		{args: "1.*", want: "[1.*, )"},
+		{args: "1.2.3.*", want: "[1.2.3.*, )"},
+		{args: "*", want: "[*, )"},
+		{args: "*-*", want: "[*-*, )"},
+		{args: "1.2.*-*", want: "[1.2.*-*, )"},
+		{args: "1.0-beta*", want: "[1.0.0-beta*, )"},
+		{args: "1.0.0-*", want: "[1.0.0-*, )"},
+		{args: "[1.*, 2.0)", want: "[1.*, 2.0.0)"},
+
+		{args: "", wantErr: ErrorNoConstraint},
+		{args: "[1.0,2.0", wantErr: ErrorUnclosedRange},
+		{args: "(1.0)", wantErr: ErrorSingleVersionRange},
+		{args: "[1.0,1.0]", wantErr: ErrorIdenticalBounds},
+		{args: "[1.0,2.0),[3.0,4.0)", wantErr: ErrorInvalidRange},
+		{args: "[2.0,1.0]", wantErr: ErrorRangeDefiesOrdering},
+		{args: "(1.0,1.0.0]", wantErr: ErrorRangeDefiesOrdering},
+		{args: "[1.0,2.*)", wantErr: ErrorFloatingUpperBound},
+		{args: "1.*.3", wantErr: ErrorInvalidFloat},
+		{args: "1*", wantErr: ErrorInvalidFloat},
+		{args: "1.2.3.4.*", wantErr: ErrorInvalidFloat},
+		{args: "1.*-beta*", wantErr: ErrorInvalidFloat},
+		{args: "1.0-be*ta", wantErr: ErrorInvalidFloat},
+		{args: "1.0.x", wantErr: ErrorInvalidVersionNumber},
+	}
+	for _, tt := range tests {
+		t.Run(tt.args, func(t *testing.T) {
+			got, err := ParseConstraint(tt.args)
+			if err != tt.wantErr {
+				t.Errorf("ParseConstraint() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && got.String() != tt.want {
+				t.Errorf("ParseConstraint().String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConstraint_Check(t *testing.T) {
+	tests := []struct {
+		constraint string
+		version    string
+		want       bool
+	}{
+		{"1.0", "1.0.0.0", true},
+		{"1.0", "5.0", true},
+		{"1.0", "1.0-beta", false},
+		{"[1.0,2.0)", "2.0", false},
+		{"[1.0,2.0)", "2.0-beta", true},
+		{"(1.0,2.0]", "1.0", false},
+		{"(1.0,2.0]", "2.0.0.0", true},
+		{"[1.0]", "1.0.0.0", true},
+		{"[1.0]", "1.0.0.1", false},
+		{"1.*", "2.0", true},
+		{"1.*", "0.9", false},
+		{"1.0-*", "1.0-0", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.constraint+" "+tt.version, func(t *testing.T) {
+			if got := MustParseConstraint(tt.constraint).Check(MustParse(tt.version)); got != tt.want {
+				t.Errorf("Check() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConstraint_FindBestMatch(t *testing.T) {
+	options, _ := ParseMultiple([]string{"0.9", "1.0", "1.1", "1.2-beta.2", "1.2-beta.10", "1.2-rc.1", "1.2.1", "2.0",
+		"3.0-alpha"})
+
+	tests := []struct {
+		constraint string
+		want       string
+	}{
+		{"1.0", "1.0.0"},
+		{"1.1.5", "1.2.0-beta.2"},
+		{"[1.0, 2.0)", "1.0.0"},
+		{"1.*", "1.2.1"},
+		{"1.1.*", "1.1.0"},
+		{"*", "2.0.0"},
+		{"*-*", "3.0.0-alpha"},
+		{"1.2-beta*", "1.2.0-beta.10"},
+		{"1.2-*", "1.2.0-rc.1"},
+		{"1.3.*", "2.0.0"},
+		{"[1.*, 1.1]", "1.1.0"},
+		{"0.5.*", "0.9.0"},
+		{"(, 0.5]", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.constraint, func(t *testing.T) {
+			var got string
+			if v := MustParseConstraint(tt.constraint).FindBestMatch(options); v != nil {
+				got = v.String()
+			}
+			if got != tt.want {
+				t.Errorf("FindBestMatch() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilter(t *testing.T) {
+	options, _ := ParseMultiple([]string{"2.1", "1.0-beta", "1.0", "1.9.9.9", "2.0"})
+	original := make(Slice, len(options))
+	copy(original, options)
+
+	got, err := Filter("[1.0,2.0)", options)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := Slice{options[2], options[3]}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Filter() = %v, want %v", got, want)
+	}
+	if !reflect.DeepEqual(options, original) {
+		t.Errorf("Filter() modified options to %v", options)
+	}
+}
@@ -0,0 +1,125 @@
+package nuget
+
+import (
+	"errors"
+	"strings"
+)
+
+var ErrorInvalidFloat = errors.New("nuget: ParseConstraint: invalid floating version")
+
+// floatRange is a floating version, eg `1.*`, `1.2.*-*` or `1.0.0-beta*`, which NuGet uses to pick the newest
+// version that matches the pattern.
+type floatRange struct {
+	// min is the lowest version that matches the pattern, eg 1.0.0 for `1.*`.
+	min *Version
+	// fixed is the number of numeric parts that must match min, or -1 if the pattern floats the pre-release label.
+	fixed int
+	// includePrerelease is true if the numeric parts float and pre-releases match, as in `1.*-*`.
+	includePrerelease bool
+	// prefix is the start of the pre-release label that must match, if fixed is -1.
+	prefix string
+}
+
+func isFloat(in string) bool {
+	return strings.IndexByte(in, '*') != -1
+}
+
+func parseFloat(in string) (*floatRange, error) {
+	core, label := in, ""
+	hasLabel := false
+	if i := strings.IndexByte(in, '-'); i != -1 {
+		core, label, hasLabel = in[:i], in[i+1:], true
+	}
+
+	f := new(floatRange)
+
+	if !strings.HasSuffix(core, "*") {
+		// a floating pre-release label, eg `1.0.0-beta*`
+		if !hasLabel || !strings.HasSuffix(label, "*") || strings.Count(label, "*") != 1 || isFloat(core) {
+			return nil, ErrorInvalidFloat
+		}
+		f.fixed = -1
+		f.prefix = label[:len(label)-1]
+
+		minLabel := strings.TrimSuffix(f.prefix, ".")
+		if minLabel == "" {
+			// the lowest possible pre-release label
+			minLabel = "0"
+		}
+		min, err := Parse(core + "-" + minLabel)
+		if err != nil {
+			return nil, err
+		}
+		f.min = min
+		return f, nil
+	}
+
+	// floating numeric parts, eg `1.*` or `1.*-*`
+	if hasLabel {
+		if label != "*" {
+			return nil, ErrorInvalidFloat
+		}
+		f.includePrerelease = true
+	}
+
+	fixedParts := strings.TrimSuffix(core, "*")
+	if isFloat(fixedParts) || (fixedParts != "" && !strings.HasSuffix(fixedParts, ".")) {
+		return nil, ErrorInvalidFloat
+	}
+
+	f.min = new(Version)
+	if fixedParts != "" {
+		f.fixed = strings.Count(fixedParts, ".")
+		if f.fixed > 3 {
+			return nil, ErrorInvalidFloat
+		}
+		min, err := Parse(strings.TrimSuffix(fixedParts, "."))
+		if err != nil {
+			return nil, err
+		}
+		f.min = min
+	}
+	if f.includePrerelease {
+		f.min.Prerelease = []string{"0"}
+	}
+
+	return f, nil
+}
+
+// satisfies returns true if v matches the pattern.
+func (f *floatRange) satisfies(v *Version) bool {
+	if f.fixed == -1 {
+		return f.min.compareNumbers(v) == 0 &&
+			strings.HasPrefix(strings.ToLower(strings.Join(v.Prerelease, ".")), strings.ToLower(f.prefix))
+	}
+
+	if v.IsPrerelease() && !f.includePrerelease {
+		return false
+	}
+	vParts := []int{v.Major, v.Minor, v.Patch, v.Revision}
+	minParts := []int{f.min.Major, f.min.Minor, f.min.Patch, f.min.Revision}
+	for i := 0; i < f.fixed; i += 1 {
+		if vParts[i] != minParts[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (f *floatRange) String() string {
+	core := &Version{Major: f.min.Major, Minor: f.min.Minor, Patch: f.min.Patch, Revision: f.min.Revision}
+
+	if f.fixed == -1 {
+		return core.String() + "-" + f.prefix + "*"
+	}
+
+	x := strings.Join(strings.Split(core.String(), ".")[:f.fixed], ".")
+	if x != "" {
+		x += "."
+	}
+	x += "*"
+	if f.includePrerelease {
+		x += "-*"
+	}
+	return x
+}
@@ -0,0 +1,160 @@
+// Package nuget parses and compares NuGet package versions, including the four-part versions used by .NET
+// assemblies.
+package nuget
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrorEmptyVersion         = errors.New("nuget: Parse: empty version")
+	ErrorInvalidVersionNumber = errors.New("nuget: Parse: version must have between one and four numeric parts")
+	ErrorEmptyIdentifier      = errors.New("nuget: Parse: empty pre-release label or metadata identifier")
+	ErrorLeadingZero          = errors.New("nuget: Parse: numeric pre-release label with leading zero")
+	ErrorInvalidCharacter     = errors.New("nuget: Parse: invalid character in pre-release label or metadata")
+)
+
+// Version is a NuGet package version, eg `1.2.3.4`, `1.0` or `2.0.0-RC1+build.5`. Versions with fewer than four
+// numeric parts are padded with zeros, so `1.0` is the same as `1.0.0.0`.
+type Version struct {
+	Major, Minor, Patch, Revision int
+	// Prerelease is the pre-release label split on dots, eg `[]string{"RC1"}`. It's compared case-insensitively.
+	Prerelease []string
+	// Metadata is the build metadata, which is ignored when comparing versions.
+	Metadata string
+}
+
+// Parse parses a NuGet version.
+func Parse(in string) (*Version, error) {
+	in = strings.TrimSpace(in)
+	if in == "" {
+		return nil, ErrorEmptyVersion
+	}
+
+	v := new(Version)
+
+	if i := strings.IndexByte(in, '+'); i != -1 {
+		v.Metadata = in[i+1:]
+		in = in[:i]
+		if err := validateIdentifiers(v.Metadata, true); err != nil {
+			return nil, err
+		}
+	}
+
+	if i := strings.IndexByte(in, '-'); i != -1 {
+		label := in[i+1:]
+		in = in[:i]
+		if err := validateIdentifiers(label, false); err != nil {
+			return nil, err
+		}
+		v.Prerelease = strings.Split(label, ".")
+	}
+
+	parts := strings.Split(in, ".")
+	if len(parts) > 4 {
+		return nil, ErrorInvalidVersionNumber
+	}
+	numbers := []*int{&v.Major, &v.Minor, &v.Patch, &v.Revision}
+	for i, part := range parts {
+		if part == "" || strings.Trim(part, "0123456789") != "" {
+			return nil, ErrorInvalidVersionNumber
+		}
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, ErrorInvalidVersionNumber
+		}
+		*numbers[i] = n
+	}
+
+	return v, nil
+}
+
+func validateIdentifiers(in string, allowLeadingZeros bool) error {
+	for _, identifier := range strings.Split(in, ".") {
+		if identifier == "" {
+			return ErrorEmptyIdentifier
+		}
+		for _, char := range []byte(identifier) {
+			if !(isAlphanumeric(char) || char == '-') {
+				return ErrorInvalidCharacter
+			}
+		}
+		if !allowLeadingZeros && len(identifier) > 1 && identifier[0] == '0' && isNumeric(identifier) {
+			return ErrorLeadingZero
+		}
+	}
+	return nil
+}
+
+// MustParse is the same as Parse, but panics if the version can't be parsed.
+func MustParse(in string) *Version {
+	v, err := Parse(in)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// ParseMultiple parses every version in rawVersions, stopping at the first one that can't be parsed.
+func ParseMultiple(rawVersions []string) (Slice, error) {
+	var x Slice
+	for _, rawVersion := range rawVersions {
+		parsedVersion, err := Parse(rawVersion)
+		if err != nil {
+			return nil, err
+		}
+		x = append(x, parsedVersion)
+	}
+	return x, nil
+}
+
+func isAlphanumeric(char byte) bool {
+	return ('0' <= char && char <= '9') || ('a' <= char && char <= 'z') || ('A' <= char && char <= 'Z')
+}
+
+func isNumeric(in string) bool {
+	for _, char := range []byte(in) {
+		if !('0' <= char && char <= '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// IsPrerelease returns true if the version has a pre-release label.
+func (v *Version) IsPrerelease() bool {
+	return len(v.Prerelease) != 0
+}
+
+// String returns the normalised form of the version, which has three numeric parts, or four if the revision isn't
+// zero, eg `1.0.0` for `1.0`.
+func (v *Version) String() string {
+	x := strconv.Itoa(v.Major) + "." + strconv.Itoa(v.Minor) + "." + strconv.Itoa(v.Patch)
+	if v.Revision != 0 {
+		x += "." + strconv.Itoa(v.Revision)
+	}
+	if len(v.Prerelease) != 0 {
+		x += "-" + strings.Join(v.Prerelease, ".")
+	}
+	if v.Metadata != "" {
+		x += "+" + v.Metadata
+	}
+	return x
+}
+
+// Slice is a sortable slice of versions.
+type Slice []*Version
+
+func (s Slice) Len() int {
+	return len(s)
+}
+
+func (s Slice) Less(i, j int) bool {
+	return s[i].CompareTo(s[j]) == -1
+}
+
+func (s Slice) Swap(i, j int) {
+	s[j], s[i] = s[i], s[j]
+}
@@ -0,0 +1,64 @@
+package nuget
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		args    string
+		want    *Version
+		wantErr error
+	}{
+		{args: "1.2.3.4", want: &Version{Major: 1, Minor: 2, Patch: 3, Revision: 4}},
+		{args: "1.0", want: &Version{Major: 1}},
+		{args: "1", want: &Version{Major: 1}},
+		{args: " 01.002.3 ", want: &Version{Major: 1, Minor: 2, Patch: 3}},
+		{args: "2.0.0-RC1", want: &Version{Major: 2, Prerelease: []string{"RC1"}}},
+		{args: "1.0.0-beta.2+git.abc-1", want: &Version{Major: 1, Prerelease: []string{"beta", "2"}, Metadata: "git.abc-1"}},
+		{args: "1.0+001", want: &Version{Major: 1, Metadata: "001"}},
+
+		{args: "", wantErr: ErrorEmptyVersion},
+		{args: "1.2.3.4.5", wantErr: ErrorInvalidVersionNumber},
+		{args: "1..2", wantErr: ErrorInvalidVersionNumber},
+		{args: "v1.0", wantErr: ErrorInvalidVersionNumber},
+		{args: "-1.0", wantErr: ErrorInvalidVersionNumber},
+		{args: "1.0-", wantErr: ErrorEmptyIdentifier},
+		{args: "1.0-beta..1", wantErr: ErrorEmptyIdentifier},
+		{args: "1.0+", wantErr: ErrorEmptyIdentifier},
+		{args: "1.0-01", wantErr: ErrorLeadingZero},
+		{args: "1.0-beta_1", wantErr: ErrorInvalidCharacter},
+	}
+	for _, tt := range tests {
+		t.Run(tt.args, func(t *testing.T) {
+			got, err := Parse(tt.args)
+			if err != tt.wantErr {
+				t.Errorf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVersion_String(t *testing.T) {
+	tests := []struct {
+		args string
+		want string
+	}{
+		{"1.0", "1.0.0"},
+		{"1.0.0.0", "1.0.0"},
+		{"1.2.3.4", "1.2.3.4"},
+		{"01.0-RC.1+Build", "1.0.0-RC.1+Build"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.args, func(t *testing.T) {
+			if got := MustParse(tt.args).String(); got != tt.want {
+				t.Errorf("String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
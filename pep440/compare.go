@@ -0,0 +1,120 @@
+package pep440
+
+import "strconv"
+
+func compareInts(a, b int) int {
+	switch {
+	case a > b:
+		return 1
+	case a < b:
+		return -1
+	default:
+		return 0
+	}
+}
+
+// compareRelease compares two release segments, treating any missing trailing components as zeros.
+func compareRelease(a, b []int) int {
+	for i := 0; i < len(a) || i < len(b); i += 1 {
+		var x, y int
+		if i < len(a) {
+			x = a[i]
+		}
+		if i < len(b) {
+			y = b[i]
+		}
+		if c := compareInts(x, y); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+var phaseOrder = map[Phase]int{PhaseAlpha: 0, PhaseBeta: 1, PhaseReleaseCandidate: 2}
+
+// comparePre compares the pre-release part of two versions.
+func comparePre(v, vx *Version) int {
+	// a development release of a final release, eg `1.0.dev1`, comes before any pre-release of it, and a final
+	// release comes after them all
+	rank := func(v *Version) int {
+		switch {
+		case v.Pre != nil:
+			return 0
+		case v.Post == nil && v.Dev != nil:
+			return -1
+		default:
+			return 1
+		}
+	}
+
+	if c := compareInts(rank(v), rank(vx)); c != 0 || v.Pre == nil {
+		return c
+	}
+
+	if c := compareInts(phaseOrder[v.Pre.Phase], phaseOrder[vx.Pre.Phase]); c != 0 {
+		return c
+	}
+	return compareInts(v.Pre.Number, vx.Pre.Number)
+}
+
+// compareOptional compares two optional numbers. If missing is -1, a missing number comes before any other number,
+// and if it is 1, after.
+func compareOptional(a, b *int, missing int) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return missing
+	case b == nil:
+		return -missing
+	default:
+		return compareInts(*a, *b)
+	}
+}
+
+// compareLocal compares two local version labels. A version without a label comes before any version with one,
+// numeric parts come after alphanumeric ones and shorter labels come before longer ones with the same prefix.
+func compareLocal(a, b []string) int {
+	for i := 0; i < len(a) && i < len(b); i += 1 {
+		x, xerr := strconv.Atoi(a[i])
+		y, yerr := strconv.Atoi(b[i])
+
+		var c int
+		switch {
+		case xerr == nil && yerr == nil:
+			c = compareInts(x, y)
+		case xerr == nil:
+			c = 1
+		case yerr == nil:
+			c = -1
+		case a[i] > b[i]:
+			c = 1
+		case a[i] < b[i]:
+			c = -1
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return compareInts(len(a), len(b))
+}
+
+// CompareTo compares two versions using the ordering given in PEP 440. 1 is v > vx, -1 is v < vx, 0 is v == vx
+func (v *Version) CompareTo(vx *Version) int {
+	if c := compareInts(v.Epoch, vx.Epoch); c != 0 {
+		return c
+	}
+	if c := compareRelease(v.Release, vx.Release); c != 0 {
+		return c
+	}
+	if c := comparePre(v, vx); c != 0 {
+		return c
+	}
+	if c := compareOptional(v.Post, vx.Post, -1); c != 0 {
+		return c
+	}
+	if c := compareOptional(v.Dev, vx.Dev, 1); c != 0 {
+		return c
+	}
+	return compareLocal(v.Local, vx.Local)
+}
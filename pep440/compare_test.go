@@ -0,0 +1,57 @@
+package pep440
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// orderedVersions is in ascending order, taken from the example in PEP 440 and the tests of the packaging library
+var orderedVersions = []string{
+	"1.0.dev456", "1.0a1", "1.0a2.dev456", "1.0a12.dev456", "1.0a12", "1.0b1.dev456", "1.0b2", "1.0b2.post345.dev456",
+	"1.0b2.post345", "1.0b2-346", "1.0c1.dev456", "1.0c1", "1.0rc2", "1.0c3", "1.0", "1.0+abc.5", "1.0+abc.7",
+	"1.0+5", "1.0.post456.dev34", "1.0.post456", "1.0.15", "1.1.dev1", "1!0.1",
+}
+
+func TestVersion_CompareTo(t *testing.T) {
+	vs, err := ParseMultiple(orderedVersions)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, a := range vs {
+		for j, b := range vs {
+			want := 0
+			if i < j {
+				want = -1
+			} else if i > j {
+				want = 1
+			}
+			if got := a.CompareTo(b); got != want {
+				t.Errorf("Version(%s).CompareTo(%s) = %v, want %v", a, b, got, want)
+			}
+		}
+	}
+}
+
+func TestVersion_CompareToEqual(t *testing.T) {
+	for _, x := range [][2]string{{"1.0", "1.0.0"}, {"1.0", "1"}, {"0!1.0", "1.0"}, {"1.0a", "1.0a0"}, {"1.0-ALPHA1", "1.0a1"}} {
+		if got := MustParse(x[0]).CompareTo(MustParse(x[1])); got != 0 {
+			t.Errorf("Version(%s).CompareTo(%s) = %v, want 0", x[0], x[1], got)
+		}
+	}
+}
+
+func TestSliceSort(t *testing.T) {
+	want, _ := ParseMultiple(orderedVersions)
+
+	got := make(Slice, len(want))
+	copy(got, want)
+	rand.New(rand.NewSource(1)).Shuffle(len(got), got.Swap)
+	sort.Sort(got)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Sorted slice is %v, want %v", got, want)
+	}
+}
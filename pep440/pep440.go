@@ -0,0 +1,225 @@
+// Package pep440 parses and compares Python package versions as described by PEP 440.
+package pep440
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var ErrorInvalidVersion = errors.New("pep440: Parse: invalid version")
+
+// Phase is the kind of a pre-release.
+type Phase string
+
+const (
+	PhaseAlpha            Phase = "a"
+	PhaseBeta             Phase = "b"
+	PhaseReleaseCandidate Phase = "rc"
+)
+
+// Pre is a pre-release, eg `rc1`.
+type Pre struct {
+	Phase  Phase
+	Number int
+}
+
+// Version is a PEP 440 version, eg `1!2.0.1rc1.post2.dev3+ubuntu1`. Optional parts of the version that aren't present
+// are nil.
+type Version struct {
+	// Epoch is 0 unless specified, eg `1` in `1!2.0`.
+	Epoch int
+	// Release is the release segment, eg `[2, 0, 1]` in `2.0.1`. It always has at least one element.
+	Release []int
+	// Pre is the pre-release, eg `rc1` in `2.0rc1`.
+	Pre *Pre
+	// Post is the post-release number, eg `2` in `2.0.post2`.
+	Post *int
+	// Dev is the development release number, eg `3` in `2.0.dev3`.
+	Dev *int
+	// Local is the local version label, split on dots, eg `[ubuntu, 1]` in `2.0+ubuntu.1`.
+	Local []string
+}
+
+// versionPattern is the regular expression given in PEP 440 that matches any version that can be normalised.
+var versionPattern = regexp.MustCompile(`^\s*v?` +
+	`(?:(?P<epoch>[0-9]+)!)?` +
+	`(?P<release>[0-9]+(?:\.[0-9]+)*)` +
+	`(?P<pre>[-_.]?(?P<pre_l>alpha|a|beta|b|preview|pre|c|rc)[-_.]?(?P<pre_n>[0-9]+)?)?` +
+	`(?P<post>(?:-(?P<post_n1>[0-9]+))|(?:[-_.]?(?P<post_l>post|rev|r)[-_.]?(?P<post_n2>[0-9]+)?))?` +
+	`(?P<dev>[-_.]?(?P<dev_l>dev)[-_.]?(?P<dev_n>[0-9]+)?)?` +
+	`(?:\+(?P<local>[a-z0-9]+(?:[-_.][a-z0-9]+)*))?` +
+	`\s*$`)
+
+// Parse parses a PEP 440 version. Any version that PEP 440 allows to be normalised is accepted, so `1.0-ALPHA_1` is
+// parsed the same as `1.0a1`.
+func Parse(in string) (*Version, error) {
+	match := versionPattern.FindStringSubmatch(strings.ToLower(in))
+	if match == nil {
+		return nil, ErrorInvalidVersion
+	}
+
+	group := func(name string) string {
+		for i, x := range versionPattern.SubexpNames() {
+			if x == name {
+				return match[i]
+			}
+		}
+		return ""
+	}
+
+	v := new(Version)
+	var err error
+
+	if epoch := group("epoch"); epoch != "" {
+		if v.Epoch, err = strconv.Atoi(epoch); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, x := range strings.Split(group("release"), ".") {
+		n, err := strconv.Atoi(x)
+		if err != nil {
+			return nil, err
+		}
+		v.Release = append(v.Release, n)
+	}
+
+	if group("pre") != "" {
+		v.Pre = &Pre{Phase: normalisePhase(group("pre_l"))}
+		if v.Pre.Number, err = optionalNumber(group("pre_n")); err != nil {
+			return nil, err
+		}
+	}
+
+	if group("post") != "" {
+		n, err := optionalNumber(group("post_n1") + group("post_n2"))
+		if err != nil {
+			return nil, err
+		}
+		v.Post = &n
+	}
+
+	if group("dev") != "" {
+		n, err := optionalNumber(group("dev_n"))
+		if err != nil {
+			return nil, err
+		}
+		v.Dev = &n
+	}
+
+	if local := group("local"); local != "" {
+		v.Local = strings.FieldsFunc(local, func(char rune) bool { return char == '-' || char == '_' || char == '.' })
+	}
+
+	return v, nil
+}
+
+// MustParse is the same as Parse, but panics if the version can't be parsed.
+func MustParse(in string) *Version {
+	v, err := Parse(in)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// ParseMultiple parses every version in rawVersions, stopping at the first one that can't be parsed.
+func ParseMultiple(rawVersions []string) (Slice, error) {
+	var x Slice
+	for _, rawVersion := range rawVersions {
+		parsedVersion, err := Parse(rawVersion)
+		if err != nil {
+			return nil, err
+		}
+		x = append(x, parsedVersion)
+	}
+	return x, nil
+}
+
+func normalisePhase(label string) Phase {
+	switch label {
+	case "a", "alpha":
+		return PhaseAlpha
+	case "b", "beta":
+		return PhaseBeta
+	default:
+		// c, pre, preview and rc
+		return PhaseReleaseCandidate
+	}
+}
+
+// optionalNumber parses a number that defaults to 0 if it isn't given.
+func optionalNumber(x string) (int, error) {
+	if x == "" {
+		return 0, nil
+	}
+	return strconv.Atoi(x)
+}
+
+// IsPrerelease returns true if v is a pre-release or a development release.
+func (v *Version) IsPrerelease() bool {
+	return v.Pre != nil || v.Dev != nil
+}
+
+// IsPostRelease returns true if v is a post-release.
+func (v *Version) IsPostRelease() bool {
+	return v.Post != nil
+}
+
+// Public returns a copy of v without its local version label.
+func (v *Version) Public() *Version {
+	x := *v
+	x.Local = nil
+	return &x
+}
+
+// String returns the normalised form of v, eg `1.0a1.post2`.
+func (v *Version) String() string {
+	var sb strings.Builder
+
+	if v.Epoch != 0 {
+		sb.WriteString(strconv.Itoa(v.Epoch) + "!")
+	}
+
+	for i, n := range v.Release {
+		if i != 0 {
+			sb.WriteByte('.')
+		}
+		sb.WriteString(strconv.Itoa(n))
+	}
+
+	if v.Pre != nil {
+		sb.WriteString(string(v.Pre.Phase) + strconv.Itoa(v.Pre.Number))
+	}
+
+	if v.Post != nil {
+		sb.WriteString(".post" + strconv.Itoa(*v.Post))
+	}
+
+	if v.Dev != nil {
+		sb.WriteString(".dev" + strconv.Itoa(*v.Dev))
+	}
+
+	if len(v.Local) != 0 {
+		sb.WriteString("+" + strings.Join(v.Local, "."))
+	}
+
+	return sb.String()
+}
+
+// Slice is a sortable slice of versions.
+type Slice []*Version
+
+func (s Slice) Len() int {
+	return len(s)
+}
+
+func (s Slice) Less(i, j int) bool {
+	return s[i].CompareTo(s[j]) == -1
+}
+
+func (s Slice) Swap(i, j int) {
+	s[j], s[i] = s[i], s[j]
+}
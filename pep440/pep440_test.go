@@ -0,0 +1,97 @@
+package pep440
+
+import (
+	"reflect"
+	"testing"
+)
+
+func intp(x int) *int {
+	return &x
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		args    string
+		want    *Version
+		wantErr bool
+	}{
+		{args: "1.0", want: &Version{Release: []int{1, 0}}},
+		{args: "2012.4", want: &Version{Release: []int{2012, 4}}},
+		{args: "1!2.0", want: &Version{Epoch: 1, Release: []int{2, 0}}},
+		{args: "1.0a1", want: &Version{Release: []int{1, 0}, Pre: &Pre{PhaseAlpha, 1}}},
+		{args: "1.0b2", want: &Version{Release: []int{1, 0}, Pre: &Pre{PhaseBeta, 2}}},
+		{args: "1.0rc3", want: &Version{Release: []int{1, 0}, Pre: &Pre{PhaseReleaseCandidate, 3}}},
+		{args: "1.0.post4", want: &Version{Release: []int{1, 0}, Post: intp(4)}},
+		{args: "1.0.dev5", want: &Version{Release: []int{1, 0}, Dev: intp(5)}},
+		{args: "1.0rc1.post2.dev3", want: &Version{Release: []int{1, 0}, Pre: &Pre{PhaseReleaseCandidate, 1}, Post: intp(2), Dev: intp(3)}},
+		{args: "1.0+ubuntu1", want: &Version{Release: []int{1, 0}, Local: []string{"ubuntu1"}}},
+		{args: "1.0+ubuntu-1_2.3", want: &Version{Release: []int{1, 0}, Local: []string{"ubuntu", "1", "2", "3"}}},
+
+		// normalisation
+		{args: " v1.0 ", want: &Version{Release: []int{1, 0}}},
+		{args: "1.0-ALPHA_1", want: &Version{Release: []int{1, 0}, Pre: &Pre{PhaseAlpha, 1}}},
+		{args: "1.0beta", want: &Version{Release: []int{1, 0}, Pre: &Pre{PhaseBeta, 0}}},
+		{args: "1.0c1", want: &Version{Release: []int{1, 0}, Pre: &Pre{PhaseReleaseCandidate, 1}}},
+		{args: "1.0pre1", want: &Version{Release: []int{1, 0}, Pre: &Pre{PhaseReleaseCandidate, 1}}},
+		{args: "1.0preview1", want: &Version{Release: []int{1, 0}, Pre: &Pre{PhaseReleaseCandidate, 1}}},
+		{args: "1.0-1", want: &Version{Release: []int{1, 0}, Post: intp(1)}},
+		{args: "1.0.rev2", want: &Version{Release: []int{1, 0}, Post: intp(2)}},
+		{args: "1.0r", want: &Version{Release: []int{1, 0}, Post: intp(0)}},
+		{args: "1.0-dev", want: &Version{Release: []int{1, 0}, Dev: intp(0)}},
+		{args: "1.01", want: &Version{Release: []int{1, 1}}},
+
+		{args: "", wantErr: true},
+		{args: "1.0.", wantErr: true},
+		{args: "1.0-", wantErr: true},
+		{args: "1.0+", wantErr: true},
+		{args: "1.0gamma1", wantErr: true},
+		{args: "1.0+ubuntu!", wantErr: true},
+		{args: "vv1.0", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.args, func(t *testing.T) {
+			got, err := Parse(tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVersion_String(t *testing.T) {
+	tests := []struct {
+		args string
+		want string
+	}{
+		{args: "1.0", want: "1.0"},
+		{args: "1!2.0.1rc1.post2.dev3+ubuntu.1", want: "1!2.0.1rc1.post2.dev3+ubuntu.1"},
+		{args: "1.0-ALPHA_1", want: "1.0a1"},
+		{args: "1.0-1", want: "1.0.post1"},
+		{args: "1.0-dev", want: "1.0.dev0"},
+		{args: "1.0+Ubuntu-1", want: "1.0+ubuntu.1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.args, func(t *testing.T) {
+			if got := MustParse(tt.args).String(); got != tt.want {
+				t.Errorf("Version.String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVersion_IsPrerelease(t *testing.T) {
+	for _, x := range []string{"1.0a1", "1.0.dev1", "1.0.post1.dev1"} {
+		if !MustParse(x).IsPrerelease() {
+			t.Errorf("Version(%s).IsPrerelease() = false, want true", x)
+		}
+	}
+	for _, x := range []string{"1.0", "1.0.post1", "1.0+local"} {
+		if MustParse(x).IsPrerelease() {
+			t.Errorf("Version(%s).IsPrerelease() = true, want false", x)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package pep440
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/codemicro/go-semver/semver"
+)
+
+var (
+	ErrorEpoch              = errors.New("pep440: semantic versions cannot have an epoch")
+	ErrorTooManyComponents  = errors.New("pep440: semantic versions cannot have more than three release components")
+	ErrorPostRelease        = errors.New("pep440: semantic versions cannot have a post-release")
+	ErrorDevelopmentRelease = errors.New("pep440: semantic versions cannot have a development release")
+	ErrorSemverPrerelease   = errors.New("pep440: prerelease must be a.N, b.N or rc.N")
+	ErrorSemverBuild        = errors.New("pep440: build metadata must only contain lowercase letters and numbers")
+	ErrorSemverTooLarge     = errors.New("pep440: release numbers must fit in an int")
+	ErrorLocalVersion       = errors.New("pep440: local version label is not valid build metadata")
+)
+
+// ToSemver converts v to a semantic version. Only versions with no epoch, at most three release components, and no
+// post-release or development release can be converted, since there is no way to represent the rest while keeping
+// the same ordering. A pre-release, eg `1.2rc1`, becomes `1.2.0-rc.1`.
+//
+// A local version label becomes build metadata, eg `1.2.3+ubuntu.1`. Unlike PEP 440, semantic versions ignore build
+// metadata when ordering versions, so versions that only differ in their local label are equal once converted. A label
+// that isn't valid build metadata, such as `01`, which has a leading zero, returns an error matching ErrorLocalVersion
+// that also wraps the *semver.ParseError.
+func (v *Version) ToSemver() (*semver.Version, error) {
+	switch {
+	case v.Epoch != 0:
+		return nil, ErrorEpoch
+	case len(v.Release) > 3:
+		return nil, ErrorTooManyComponents
+	case v.Post != nil:
+		return nil, ErrorPostRelease
+	case v.Dev != nil:
+		return nil, ErrorDevelopmentRelease
+	}
+
+	core := make([]string, 3)
+	for i := range core {
+		core[i] = "0"
+		if i < len(v.Release) {
+			core[i] = strconv.Itoa(v.Release[i])
+		}
+	}
+
+	x := strings.Join(core, ".")
+	if v.Pre != nil {
+		x += "-" + string(v.Pre.Phase) + "." + strconv.Itoa(v.Pre.Number)
+	}
+	if len(v.Local) != 0 {
+		x += "+" + strings.Join(v.Local, ".")
+	}
+
+	sv, err := semver.Parse(x)
+	if err != nil {
+		// everything but the local label is known to be valid
+		return nil, fmt.Errorf("%w: %w", ErrorLocalVersion, err)
+	}
+	return sv, nil
+}
+
+// FromSemver converts a semantic version to a PEP 440 version. This is the reverse of Version.ToSemver, so the
+// prerelease identifiers, if there are any, must be `a`, `b` or `rc` followed by a number, eg `1.2.0-rc.1`,
+// and the build metadata must only contain lowercase letters and numbers. The major, minor and patch versions must fit
+// in an int.
+func FromSemver(sv *semver.Version) (*Version, error) {
+	if sv.Overflows() {
+		return nil, ErrorSemverTooLarge
+	}
+
+	v := &Version{Release: []int{sv.Major, sv.Minor, sv.Patch}}
+
+	if len(sv.Prerelease) != 0 {
+		if len(sv.Prerelease) != 2 {
+			return nil, ErrorSemverPrerelease
+		}
+
+		phase := Phase(sv.Prerelease[0])
+		if _, ok := phaseOrder[phase]; !ok {
+			return nil, ErrorSemverPrerelease
+		}
+
+		n, err := strconv.Atoi(sv.Prerelease[1])
+		if err != nil {
+			return nil, ErrorSemverPrerelease
+		}
+		v.Pre = &Pre{Phase: phase, Number: n}
+	}
+
+	if len(sv.Build) != 0 {
+		for _, x := range sv.Build {
+			// local version labels are only made of lowercase letters and numbers
+			if strings.ContainsRune(x, '-') || strings.ToLower(x) != x {
+				return nil, ErrorSemverBuild
+			}
+		}
+		v.Local = append([]string(nil), sv.Build...)
+	}
+
+	return v, nil
+}
@@ -0,0 +1,95 @@
+package pep440
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/codemicro/go-semver/semver"
+)
+
+func TestVersion_ToSemver(t *testing.T) {
+	tests := []struct {
+		args    string
+		want    string
+		wantErr error
+	}{
+		{args: "1.2.3", want: "1.2.3"},
+		{args: "1.2", want: "1.2.0"},
+		{args: "1", want: "1.0.0"},
+		{args: "1.2rc1", want: "1.2.0-rc.1"},
+		{args: "1.2.3a4", want: "1.2.3-a.4"},
+		{args: "1.2.3+ubuntu.1", want: "1.2.3+ubuntu.1"},
+		{args: "1!1.2.3", wantErr: ErrorEpoch},
+		{args: "1.2.3.4", wantErr: ErrorTooManyComponents},
+		{args: "1.2.3.post1", wantErr: ErrorPostRelease},
+		{args: "1.2.3.dev1", wantErr: ErrorDevelopmentRelease},
+		{args: "1.2.3+ubuntu.01", wantErr: ErrorLocalVersion},
+	}
+	for _, tt := range tests {
+		t.Run(tt.args, func(t *testing.T) {
+			got, err := MustParse(tt.args).ToSemver()
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Version.ToSemver() error = %v, want %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && got.String() != tt.want {
+				t.Errorf("Version.ToSemver() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVersion_ToSemverLocal(t *testing.T) {
+	// the local label is kept as build metadata, but isn't used for ordering
+	a, _ := MustParse("1.2.3+ubuntu.1").ToSemver()
+	b, _ := MustParse("1.2.3+ubuntu.2").ToSemver()
+	if a.CompareTo(b) != 0 {
+		t.Errorf("semver.Version(%s).CompareTo(%s) = %v, want 0", a, b, a.CompareTo(b))
+	}
+
+	_, err := MustParse("1.2.3+01").ToSemver()
+	var pe *semver.ParseError
+	if !errors.Is(err, ErrorLocalVersion) || !errors.As(err, &pe) {
+		t.Errorf("Version.ToSemver() error = %v, want ErrorLocalVersion wrapping a *semver.ParseError", err)
+	}
+}
+
+func TestFromSemver(t *testing.T) {
+	tests := []struct {
+		args    string
+		want    string
+		wantErr error
+	}{
+		{args: "1.2.3", want: "1.2.3"},
+		{args: "1.2.0-rc.1", want: "1.2.0rc1"},
+		{args: "1.2.3-b.0+ubuntu.1", want: "1.2.3b0+ubuntu.1"},
+		{args: "1.2.3-alpha.1", wantErr: ErrorSemverPrerelease},
+		{args: "1.2.3-rc", wantErr: ErrorSemverPrerelease},
+		{args: "1.2.3-rc.x", wantErr: ErrorSemverPrerelease},
+		{args: "1.2.3+Build", wantErr: ErrorSemverBuild},
+		{args: "1.2.3+build-1", wantErr: ErrorSemverBuild},
+		{args: "1.18446744073709551616.0", wantErr: ErrorSemverTooLarge},
+	}
+	for _, tt := range tests {
+		t.Run(tt.args, func(t *testing.T) {
+			sv := semver.MustParse(tt.args)
+			got, err := FromSemver(sv)
+			if err != tt.wantErr {
+				t.Errorf("FromSemver() error = %v, want %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if got.String() != tt.want {
+				t.Errorf("FromSemver() = %v, want %v", got, tt.want)
+			}
+
+			// converting back must give the same version
+			back, err := got.ToSemver()
+			if err != nil || back.String() != sv.String() {
+				t.Errorf("Version.ToSemver() = %v, %v, want %v", back, err, sv)
+			}
+		})
+	}
+}
@@ -0,0 +1,250 @@
+package pep440
+
+import (
+	"errors"
+	"strings"
+)
+
+var ErrorInvalidSpecifier = errors.New("pep440: ParseSpecifierSet: invalid specifier")
+
+// allowableOperators is ordered so that no operator comes after another operator that it starts with.
+var allowableOperators = []string{"===", "~=", "==", "!=", "<=", ">=", "<", ">"}
+
+type specifier struct {
+	operator string
+	version  *Version
+	// wildcard is set for prefix matches, eg `==1.2.*`
+	wildcard bool
+	// arbitrary is the string compared against by `===`
+	arbitrary string
+}
+
+func parseSpecifier(in string) (*specifier, error) {
+	s := new(specifier)
+	for _, operator := range allowableOperators {
+		if strings.HasPrefix(in, operator) {
+			s.operator = operator
+			break
+		}
+	}
+
+	raw := strings.TrimSpace(in[len(s.operator):])
+	if s.operator == "" || raw == "" {
+		return nil, ErrorInvalidSpecifier
+	}
+
+	if s.operator == "===" {
+		if strings.ContainsAny(raw, " \t") {
+			return nil, ErrorInvalidSpecifier
+		}
+		s.arbitrary = raw
+		return s, nil
+	}
+
+	if strings.HasSuffix(raw, ".*") {
+		s.wildcard = true
+		raw = raw[:len(raw)-2]
+	}
+
+	v, err := Parse(raw)
+	if err != nil {
+		return nil, err
+	}
+	s.version = v
+
+	switch {
+	case s.wildcard && (s.operator != "==" && s.operator != "!=" || v.Pre != nil || v.Post != nil || v.Dev != nil || v.Local != nil):
+		// a wildcard can only follow the release segment of an (in)equality
+		return nil, ErrorInvalidSpecifier
+	case v.Local != nil && s.operator != "==" && s.operator != "!=":
+		return nil, ErrorInvalidSpecifier
+	case s.operator == "~=" && len(v.Release) < 2:
+		return nil, ErrorInvalidSpecifier
+	}
+
+	return s, nil
+}
+
+// baseVersion returns the epoch and release segment of v.
+func baseVersion(v *Version) *Version {
+	return &Version{Epoch: v.Epoch, Release: v.Release}
+}
+
+// hasPrefix returns true if v's release segment, padded with zeros, starts with prefix's release segment.
+func hasPrefix(v, prefix *Version) bool {
+	if v.Epoch != prefix.Epoch {
+		return false
+	}
+	for i, n := range prefix.Release {
+		var x int
+		if i < len(v.Release) {
+			x = v.Release[i]
+		}
+		if x != n {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *specifier) check(v *Version) bool {
+	switch s.operator {
+	case "===":
+		return strings.EqualFold(v.String(), s.arbitrary)
+	case "==", "!=":
+		var equal bool
+		if s.wildcard {
+			equal = hasPrefix(v, s.version)
+		} else if s.version.Local == nil {
+			// local labels are ignored unless the specifier has one
+			equal = v.Public().CompareTo(s.version) == 0
+		} else {
+			equal = v.CompareTo(s.version) == 0
+		}
+		return equal == (s.operator == "==")
+	case "~=":
+		// `~=2.2.1` is the same as `>=2.2.1, ==2.2.*`
+		prefix := &Version{Epoch: s.version.Epoch, Release: s.version.Release[:len(s.version.Release)-1]}
+		return v.Public().CompareTo(s.version) >= 0 && hasPrefix(v, prefix)
+	case "<=":
+		return v.Public().CompareTo(s.version) <= 0
+	case ">=":
+		return v.Public().CompareTo(s.version) >= 0
+	case "<":
+		// `<V` doesn't match pre-releases of V unless V is itself a pre-release
+		if v.CompareTo(s.version) >= 0 {
+			return false
+		}
+		return s.version.IsPrerelease() || !v.IsPrerelease() || baseVersion(v).CompareTo(baseVersion(s.version)) != 0
+	case ">":
+		// `>V` doesn't match post-releases of V unless V is itself a post-release, or any local versions of V
+		if v.CompareTo(s.version) <= 0 {
+			return false
+		}
+		sameBase := baseVersion(v).CompareTo(baseVersion(s.version)) == 0
+		if sameBase && v.IsPostRelease() && !s.version.IsPostRelease() {
+			return false
+		}
+		return !sameBase || v.Local == nil
+	default:
+		return false
+	}
+}
+
+// allowsPrereleases returns true if the specifier explicitly mentions a pre-release, which allows pre-releases to
+// match the SpecifierSet that it's part of. Excluding a pre-release with `!=` doesn't count.
+func (s *specifier) allowsPrereleases() bool {
+	switch s.operator {
+	case "==", ">=", "<=", "~=", "<", ">":
+		return s.version.IsPrerelease()
+	case "===":
+		v, err := Parse(s.arbitrary)
+		return err == nil && v.IsPrerelease()
+	default:
+		return false
+	}
+}
+
+func (s *specifier) String() string {
+	if s.operator == "===" {
+		return s.operator + s.arbitrary
+	}
+	if s.wildcard {
+		return s.operator + s.version.String() + ".*"
+	}
+	return s.operator + s.version.String()
+}
+
+// SpecifierOptions changes how a SpecifierSet matches versions.
+type SpecifierOptions struct {
+	// IncludePrerelease allows pre-releases and development releases to match, even if no specifier mentions one.
+	IncludePrerelease bool
+}
+
+// SpecifierSet is a comma separated list of version specifiers, eg `~=1.4.2, !=1.4.5`. A version matches if it
+// matches every specifier.
+//
+// As in PEP 440, pre-releases and development releases only match if one of the specifiers explicitly mentions one
+// (eg `>=1.0rc1`), or SpecifierOptions.IncludePrerelease is set.
+type SpecifierSet struct {
+	options    SpecifierOptions
+	specifiers []*specifier
+}
+
+// ParseSpecifierSet parses a comma separated list of version specifiers. An empty list matches every version.
+func ParseSpecifierSet(in string) (*SpecifierSet, error) {
+	return ParseSpecifierSetWithOptions(in, SpecifierOptions{})
+}
+
+// ParseSpecifierSetWithOptions is the same as ParseSpecifierSet, but allows options to be set.
+func ParseSpecifierSetWithOptions(in string, options SpecifierOptions) (*SpecifierSet, error) {
+	s := &SpecifierSet{options: options}
+	for _, raw := range strings.Split(in, ",") {
+		raw = strings.TrimSpace(raw)
+		if raw == "" {
+			continue
+		}
+
+		spec, err := parseSpecifier(raw)
+		if err != nil {
+			return nil, err
+		}
+		s.specifiers = append(s.specifiers, spec)
+	}
+	return s, nil
+}
+
+// MustParseSpecifierSet is the same as ParseSpecifierSet, but panics if the specifiers can't be parsed.
+func MustParseSpecifierSet(in string) *SpecifierSet {
+	s, err := ParseSpecifierSet(in)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// Check returns true if v matches every specifier in s.
+func (s *SpecifierSet) Check(v *Version) bool {
+	if v.IsPrerelease() && !s.allowsPrereleases() {
+		return false
+	}
+
+	for _, spec := range s.specifiers {
+		if !spec.check(v) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *SpecifierSet) allowsPrereleases() bool {
+	if s.options.IncludePrerelease {
+		return true
+	}
+	for _, spec := range s.specifiers {
+		if spec.allowsPrereleases() {
+			return true
+		}
+	}
+	return false
+}
+
+// Filter returns the versions in vs that match s, in the same order. vs is not modified.
+func (s *SpecifierSet) Filter(vs Slice) Slice {
+	var x Slice
+	for _, v := range vs {
+		if s.Check(v) {
+			x = append(x, v)
+		}
+	}
+	return x
+}
+
+// String returns the normalised form of s, eg `~=1.4.2,!=1.4.5`.
+func (s *SpecifierSet) String() string {
+	parts := make([]string, len(s.specifiers))
+	for i, spec := range s.specifiers {
+		parts[i] = spec.String()
+	}
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,152 @@
+package pep440
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseSpecifierSet(t *testing.T) {
+	tests := []struct {
+		args    string
+		want    string
+		wantErr bool
+	}{
+		{args: "~=1.4.2, !=1.4.5", want: "~=1.4.2,!=1.4.5"},
+		{args: ">= 1.0 , < 2.0", want: ">=1.0,<2.0"},
+		{args: "==1.2.*", want: "==1.2.*"},
+		{args: "!=1.2.*", want: "!=1.2.*"},
+		{args: "==1.0+Local", want: "==1.0+local"},
+		{args: "===foobar", want: "===foobar"},
+		{args: "", want: ""},
+		{args: "==1.0,,", want: "==1.0"},
+
+		{args: "1.0", wantErr: true},
+		{args: "=1.0", wantErr: true},
+		{args: "~=1", wantErr: true},
+		{args: ">=1.*", wantErr: true},
+		{args: "==1.0a1.*", wantErr: true},
+		{args: ">=1.0+local", wantErr: true},
+		{args: "~=1.0+local", wantErr: true},
+		{args: "==", wantErr: true},
+		{args: "===foo bar", wantErr: true},
+		{args: "==1.0 junk", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.args, func(t *testing.T) {
+			got, err := ParseSpecifierSet(tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseSpecifierSet() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && got.String() != tt.want {
+				t.Errorf("ParseSpecifierSet().String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSpecifierSet_Check(t *testing.T) {
+	// most test cases taken from https://github.com/pypa/packaging/blob/main/tests/test_specifiers.py
+	tests := []struct {
+		version   string
+		specifier string
+		want      bool
+	}{
+		// ==
+		{"2.0", "==2", true},
+		{"2.0", "==2.0", true},
+		{"2.0", "==2.0.0", true},
+		{"2.0+deadbeef", "==2", true},
+		{"2.0+deadbeef", "==2.0+deadbeef", true},
+		{"2.0+deadbeef", "==2.0+deadbeef.0", false},
+		{"2.0", "==2.0+deadbeef", false},
+		{"2.1", "==2.0", false},
+		{"2.0.post1", "==2.0", false},
+
+		// prefix matching
+		{"2.0", "==2.*", true},
+		{"2.0.1", "==2.0.*", true},
+		{"2.0.post1", "==2.0.*", true},
+		{"2.0+local", "==2.0.*", true},
+		{"2", "==2.0.*", true},
+		{"2.1", "==2.0.*", false},
+		{"1!2.0", "==2.*", false},
+		{"2.0", "!=2.*", false},
+		{"3.0", "!=2.*", true},
+
+		// ~=
+		{"2.0", "~=2.0", true},
+		{"2.5", "~=2.0", true},
+		{"3.0", "~=2.0", false},
+		{"2.2.5", "~=2.2.1", true},
+		{"2.3.0", "~=2.2.1", false},
+		{"2.2.0", "~=2.2.1", false},
+		{"1!2.5", "~=2.0", false},
+
+		// <= and >=
+		{"2.0", ">=2.0", true},
+		{"2.0+local", "<=2.0", true},
+		{"1.9", ">=2.0", false},
+
+		// <
+		{"1.9", "<2.0", true},
+		{"2.0.dev1", "<2.0", false},
+		{"2.0a1", "<2.0", false},
+		{"2.0a1", "<2.0rc1", true},
+		{"1.9a1", "<2.0rc1", true},
+		{"2.0", "<2.0", false},
+
+		// >
+		{"2.1", ">2.0", true},
+		{"2.0.post1", ">2.0", false},
+		{"2.0.post2", ">2.0.post1", true},
+		{"2.0.1", ">2.0", true},
+		{"2.0+local", ">2.0", false},
+		{"2.0.1+local", ">2.0", true},
+
+		// ===
+		{"2.0", "===2.0", true},
+		{"2.0.0", "===2.0", false},
+		{"2.0a1", "===2.0a1", true},
+
+		// pre-releases are only matched if a specifier mentions one
+		{"2.0a1", ">=1.0", false},
+		{"2.0a1", ">=1.0a1", true},
+		{"2.0.dev1", ">=1.0, !=1.5rc1", false},
+		{"2.0a1", "", false},
+		{"1.5", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.version+" "+tt.specifier, func(t *testing.T) {
+			if got := MustParseSpecifierSet(tt.specifier).Check(MustParse(tt.version)); got != tt.want {
+				t.Errorf("SpecifierSet.Check() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSpecifierSet_IncludePrerelease(t *testing.T) {
+	s, err := ParseSpecifierSetWithOptions(">=1.0", SpecifierOptions{IncludePrerelease: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !s.Check(MustParse("2.0a1")) {
+		t.Errorf("SpecifierSet.Check(2.0a1) = false, want true")
+	}
+}
+
+func TestSpecifierSet_Filter(t *testing.T) {
+	vs, _ := ParseMultiple([]string{"1.4.1", "1.4.2", "1.4.5", "1.4.7", "1.5.0", "1.4.8a1"})
+	original := make(Slice, len(vs))
+	copy(original, vs)
+
+	got := MustParseSpecifierSet("~=1.4.2, !=1.4.5").Filter(vs)
+	want, _ := ParseMultiple([]string{"1.4.2", "1.4.7"})
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SpecifierSet.Filter() = %v, want %v", got, want)
+	}
+	if !reflect.DeepEqual(vs, original) {
+		t.Errorf("SpecifierSet.Filter() modified its input")
+	}
+}
@@ -0,0 +1,119 @@
+package rpm
+
+import "strings"
+
+// compareSegments compares two versions or releases using the same algorithm as rpmvercmp, where the strings are
+// split into alphabetic and numeric segments, separated by any other characters, that are compared lexically and
+// numerically.
+func compareSegments(a, b string) int {
+	if a == b {
+		return 0
+	}
+
+	var i, j int
+	for i < len(a) || j < len(b) {
+		// skip separators, except for the ones with special meaning
+		for i < len(a) && !isAlphanumeric(a[i]) && a[i] != '~' && a[i] != '^' {
+			i += 1
+		}
+		for j < len(b) && !isAlphanumeric(b[j]) && b[j] != '~' && b[j] != '^' {
+			j += 1
+		}
+
+		// a tilde sorts before everything, even the end of the version
+		aTilde, bTilde := i < len(a) && a[i] == '~', j < len(b) && b[j] == '~'
+		if aTilde || bTilde {
+			if !aTilde {
+				return 1
+			} else if !bTilde {
+				return -1
+			}
+			i += 1
+			j += 1
+			continue
+		}
+
+		// a caret sorts after the end of the version, but before everything else
+		aCaret, bCaret := i < len(a) && a[i] == '^', j < len(b) && b[j] == '^'
+		if aCaret || bCaret {
+			if i == len(a) {
+				return -1
+			} else if j == len(b) {
+				return 1
+			} else if !aCaret {
+				return 1
+			} else if !bCaret {
+				return -1
+			}
+			i += 1
+			j += 1
+			continue
+		}
+
+		if i == len(a) || j == len(b) {
+			break
+		}
+
+		// take the next segment from each, with the type decided by the first version
+		numeric := isDigit(a[i])
+		matches := isLetter
+		if numeric {
+			matches = isDigit
+		}
+
+		startA, startB := i, j
+		for i < len(a) && matches(a[i]) {
+			i += 1
+		}
+		for j < len(b) && matches(b[j]) {
+			j += 1
+		}
+		segA, segB := a[startA:i], b[startB:j]
+
+		// segments of different types can't be compared, and numeric segments are newer than alphabetic ones
+		if segB == "" {
+			if numeric {
+				return 1
+			}
+			return -1
+		}
+
+		if numeric {
+			segA, segB = strings.TrimLeft(segA, "0"), strings.TrimLeft(segB, "0")
+			if len(segA) != len(segB) {
+				if len(segA) > len(segB) {
+					return 1
+				}
+				return -1
+			}
+		}
+
+		if c := strings.Compare(segA, segB); c != 0 {
+			return c
+		}
+	}
+
+	// whichever version has anything left is newer
+	switch {
+	case i == len(a) && j == len(b):
+		return 0
+	case i == len(a):
+		return -1
+	default:
+		return 1
+	}
+}
+
+// CompareTo compares two versions in the same way as rpm. 1 is v > vx, -1 is v < vx, 0 is v == vx
+func (v *Version) CompareTo(vx *Version) int {
+	if v.Epoch != vx.Epoch {
+		if v.Epoch > vx.Epoch {
+			return 1
+		}
+		return -1
+	}
+	if c := compareSegments(v.Version, vx.Version); c != 0 {
+		return c
+	}
+	return compareSegments(v.Release, vx.Release)
+}
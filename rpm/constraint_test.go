@@ -0,0 +1,80 @@
+package rpm
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseConstraint(t *testing.T) {
+	tests := []struct {
+		args    string
+		want    string
+		wantErr error
+	}{
+		{args: ">= 2.17 < 2.18 || = 1:2.30-1.el8", want: ">=2.17 <2.18 || 1:2.30-1.el8"},
+		{args: "== 1.0", want: "1.0"},
+		{args: "!=1.0~rc1", want: "!=1.0~rc1"},
+
+		{args: "", wantErr: ErrorNoConstraint},
+		{args: ">=1.0 ||", wantErr: ErrorNoConstraint},
+		{args: ">=", wantErr: ErrorEmptyComparator},
+		{args: "=>1.0", wantErr: ErrorUnknownOperator},
+		{args: ">=1.0-", wantErr: ErrorEmptyRelease},
+	}
+	for _, tt := range tests {
+		t.Run(tt.args, func(t *testing.T) {
+			got, err := ParseConstraint(tt.args)
+			if err != tt.wantErr {
+				t.Errorf("ParseConstraint() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && got.String() != tt.want {
+				t.Errorf("ParseConstraint().String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConstraint_Check(t *testing.T) {
+	tests := []struct {
+		constraint string
+		version    string
+		want       bool
+	}{
+		{"= 2.17", "2.17-326.el7", true},
+		{"= 2.17-1", "2.17-326.el7", false},
+		{"> 2.17", "2.17-326.el7", false},
+		{"> 2.17-1", "2.17-326.el7", true},
+		{"<= 2.17", "2.17-326.el7", true},
+		{"< 2.17", "2.17~rc1-1", true},
+		{"!= 2.17", "2.17-1", false},
+		{">= 2.0", "1:1.0", true},
+		{"< 2.0 || >= 3.0", "2.5", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.constraint+" "+tt.version, func(t *testing.T) {
+			if got := MustParseConstraint(tt.constraint).Check(MustParse(tt.version)); got != tt.want {
+				t.Errorf("Check() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilter(t *testing.T) {
+	options, _ := ParseMultiple([]string{"2.28-164.el8", "2.17-326.el7", "2.17-317.el7", "2.12-1.212.el6"})
+	original := make(Slice, len(options))
+	copy(original, options)
+
+	got, err := Filter(">= 2.17-320 < 2.18", options)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := Slice{options[1]}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Filter() = %v, want %v", got, want)
+	}
+	if !reflect.DeepEqual(options, original) {
+		t.Errorf("Filter() modified options to %v", options)
+	}
+}
@@ -0,0 +1,127 @@
+// Package rpm parses and compares RPM package versions, as used by rpm, yum and dnf.
+package rpm
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/codemicro/go-semver/internal/epoch"
+)
+
+var (
+	ErrorEmptyVersion     = errors.New("rpm: Parse: empty version")
+	ErrorInvalidEpoch     = errors.New("rpm: Parse: epoch must be a non-negative number")
+	ErrorEmptyRelease     = errors.New("rpm: Parse: empty release")
+	ErrorInvalidCharacter = errors.New("rpm: Parse: invalid character in version")
+)
+
+// Version is an RPM package version, in the form `[epoch:]version[-release]`, eg `2.17-326.el7`.
+type Version struct {
+	// Epoch is 0 unless specified.
+	Epoch int
+	// Version is the version of the packaged software, eg `2.17`.
+	Version string
+	// Release is the version of the package of it, eg `326.el7`. It may be empty, in which case any release matches
+	// it in a constraint.
+	Release string
+}
+
+// Parse parses an RPM package version, also known as an EVR (epoch, version and release).
+func Parse(in string) (*Version, error) {
+	in = strings.TrimSpace(in)
+	if in == "" {
+		return nil, ErrorEmptyVersion
+	}
+
+	v := new(Version)
+
+	var ok bool
+	if v.Epoch, in, ok = epoch.Cut(in); !ok {
+		return nil, ErrorInvalidEpoch
+	}
+
+	v.Version = in
+	if i := strings.LastIndexByte(in, '-'); i != -1 {
+		v.Version, v.Release = in[:i], in[i+1:]
+		if v.Release == "" {
+			return nil, ErrorEmptyRelease
+		}
+	}
+
+	if v.Version == "" {
+		return nil, ErrorEmptyVersion
+	}
+
+	// rpm forbids hyphens in both, so the last hyphen is the only one
+	for _, part := range []string{v.Version, v.Release} {
+		for _, char := range []byte(part) {
+			if !(isAlphanumeric(char) || strings.IndexByte("._+~^", char) != -1) {
+				return nil, ErrorInvalidCharacter
+			}
+		}
+	}
+
+	return v, nil
+}
+
+// MustParse is the same as Parse, but panics if the version can't be parsed.
+func MustParse(in string) *Version {
+	v, err := Parse(in)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// ParseMultiple parses every version in rawVersions, stopping at the first one that can't be parsed.
+func ParseMultiple(rawVersions []string) (Slice, error) {
+	var x Slice
+	for _, rawVersion := range rawVersions {
+		parsedVersion, err := Parse(rawVersion)
+		if err != nil {
+			return nil, err
+		}
+		x = append(x, parsedVersion)
+	}
+	return x, nil
+}
+
+func isDigit(char byte) bool {
+	return '0' <= char && char <= '9'
+}
+
+func isLetter(char byte) bool {
+	return ('a' <= char && char <= 'z') || ('A' <= char && char <= 'Z')
+}
+
+func isAlphanumeric(char byte) bool {
+	return isDigit(char) || isLetter(char)
+}
+
+func (v *Version) String() string {
+	var x string
+	if v.Epoch != 0 {
+		x = strconv.Itoa(v.Epoch) + ":"
+	}
+	x += v.Version
+	if v.Release != "" {
+		x += "-" + v.Release
+	}
+	return x
+}
+
+// Slice is a sortable slice of versions.
+type Slice []*Version
+
+func (s Slice) Len() int {
+	return len(s)
+}
+
+func (s Slice) Less(i, j int) bool {
+	return s[i].CompareTo(s[j]) == -1
+}
+
+func (s Slice) Swap(i, j int) {
+	s[j], s[i] = s[i], s[j]
+}
@@ -0,0 +1,54 @@
+package rpm
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		args    string
+		want    *Version
+		wantErr error
+	}{
+		{args: "2.17-326.el7", want: &Version{Version: "2.17", Release: "326.el7"}},
+		{args: "1:2.30-1.el8_4", want: &Version{Epoch: 1, Version: "2.30", Release: "1.el8_4"}},
+		{args: " 1.0~rc1 ", want: &Version{Version: "1.0~rc1"}},
+		{args: "1.0^20200101git1234abc-1", want: &Version{Version: "1.0^20200101git1234abc", Release: "1"}},
+		{args: "0:1.0", want: &Version{Version: "1.0"}},
+
+		{args: "", wantErr: ErrorEmptyVersion},
+		{args: "1:", wantErr: ErrorEmptyVersion},
+		{args: "-1", wantErr: ErrorEmptyVersion},
+		{args: "a:1.0", wantErr: ErrorInvalidEpoch},
+		{args: "-1:1.0", wantErr: ErrorInvalidEpoch},
+		{args: "+1:1.0", wantErr: ErrorInvalidEpoch},
+		{args: "1.0-", wantErr: ErrorEmptyRelease},
+		{args: "1.0-beta-1", wantErr: ErrorInvalidCharacter},
+		{args: "1.0 2", wantErr: ErrorInvalidCharacter},
+		{args: "1:1.0:2", wantErr: ErrorInvalidCharacter},
+	}
+	for _, tt := range tests {
+		t.Run(tt.args, func(t *testing.T) {
+			got, err := Parse(tt.args)
+			if err != tt.wantErr {
+				t.Errorf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVersion_String(t *testing.T) {
+	for _, x := range []string{"2.17-326.el7", "1:2.30-1.el8_4", "1.0~rc1", "1.0^20200101git1234abc-1"} {
+		if got := MustParse(x).String(); got != x {
+			t.Errorf("Version(%s).String() = %v, want %v", x, got, x)
+		}
+	}
+	if got := MustParse("0:1.0").String(); got != "1.0" {
+		t.Errorf("Version(0:1.0).String() = %v, want 1.0", got)
+	}
+}
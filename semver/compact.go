@@ -0,0 +1,250 @@
+package semver
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Compact is a version in a form that is cheaper to store, copy and compare in large numbers than a *Version. The
+// version core is held in fixed-width integers and any prerelease identifiers and build metadata in a single string,
+// so a Compact of a version like `1.2.3` doesn't refer to any other memory at all.
+//
+// A version with a major, minor or patch version that is too large for an int is held entirely as a string, so is
+// slower to compare.
+//
+// The zero value is the version 0.0.0.
+type Compact struct {
+	major, minor, patch int64
+	// suffix is the prerelease identifiers and build metadata, including the leading `-` or `+`, or the whole version
+	// if large is true
+	suffix string
+	// large is true if the major, minor or patch version is too large for an int, in which case the version core
+	// fields are unused
+	large bool
+}
+
+// ParseCompact parses a version string into a Compact. Versions that only have a version core, eg `1.2.3`, are parsed
+// without allocating.
+func ParseCompact(in string) (Compact, error) {
+	if major, minor, patch, ok := parseSimple(in); ok {
+		return Compact{major: int64(major), minor: int64(minor), patch: int64(patch)}, nil
+	}
+
+	v, err := Parse(in)
+	if err != nil {
+		return Compact{}, err
+	}
+
+	if v.Overflows() {
+		return Compact{suffix: in, large: true}, nil
+	}
+
+	// in is known to be valid, so the suffix starts at the first `-` or `+`
+	c := Compact{major: int64(v.Major), minor: int64(v.Minor), patch: int64(v.Patch)}
+	if i := strings.IndexAny(in, "-+"); i != -1 {
+		c.suffix = in[i:]
+	}
+	return c, nil
+}
+
+// MustParseCompact is the same as ParseCompact, but panics if the version string can't be parsed.
+func MustParseCompact(in string) Compact {
+	c, err := ParseCompact(in)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// ParseCompactBytes is the same as ParseCompact, but takes a byte slice. Versions that only have a version core, eg
+// `1.2.3`, are parsed without allocating.
+func ParseCompactBytes(in []byte) (Compact, error) {
+	// as in ParseBytes, the string doesn't escape, so isn't allocated if it's short
+	if major, minor, patch, ok := parseSimple(string(in)); ok {
+		return Compact{major: int64(major), minor: int64(minor), patch: int64(patch)}, nil
+	}
+	return ParseCompact(string(in))
+}
+
+// Compact returns v as a Compact. If v isn't a valid version, the error is the same as from Validate.
+func (v *Version) Compact() (Compact, error) {
+	if err := v.Validate(); err != nil {
+		return Compact{}, err
+	}
+
+	if v.Overflows() {
+		return Compact{suffix: v.String(), large: true}, nil
+	}
+
+	c := Compact{major: int64(v.Major), minor: int64(v.Minor), patch: int64(v.Patch)}
+	if len(v.Prerelease) != 0 {
+		c.suffix += "-" + strings.Join(v.Prerelease, ".")
+	}
+	if len(v.Build) != 0 {
+		c.suffix += "+" + strings.Join(v.Build, ".")
+	}
+	return c, nil
+}
+
+// Version returns c as a *Version.
+func (c Compact) Version() *Version {
+	if c.large {
+		// this can only have been made from a valid version
+		return MustParse(c.suffix)
+	}
+
+	v := &Version{Major: int(c.major), Minor: int(c.minor), Patch: int(c.patch)}
+	if pre := c.Prerelease(); pre != "" {
+		v.Prerelease = strings.Split(pre, ".")
+	}
+	if build := c.Build(); build != "" {
+		v.Build = strings.Split(build, ".")
+	}
+	return v
+}
+
+// tail returns the prerelease identifiers and build metadata of c, including the leading `-` or `+`.
+func (c Compact) tail() string {
+	if !c.large {
+		return c.suffix
+	}
+	if i := strings.IndexAny(c.suffix, "-+"); i != -1 {
+		return c.suffix[i:]
+	}
+	return ""
+}
+
+// Major returns the major version of c, or the largest possible int if it is too large to fit in one, as for Version.
+func (c Compact) Major() int {
+	if c.large {
+		return c.Version().Major
+	}
+	return int(c.major)
+}
+
+// Minor returns the minor version of c, or the largest possible int if it is too large to fit in one, as for Version.
+func (c Compact) Minor() int {
+	if c.large {
+		return c.Version().Minor
+	}
+	return int(c.minor)
+}
+
+// Patch returns the patch version of c, or the largest possible int if it is too large to fit in one, as for Version.
+func (c Compact) Patch() int {
+	if c.large {
+		return c.Version().Patch
+	}
+	return int(c.patch)
+}
+
+// Prerelease returns the dot separated prerelease identifiers, eg `rc.1`, or an empty string if there aren't any.
+func (c Compact) Prerelease() string {
+	tail := c.tail()
+	if !strings.HasPrefix(tail, "-") {
+		return ""
+	}
+	pre := tail[1:]
+	if i := strings.IndexByte(pre, '+'); i != -1 {
+		pre = pre[:i]
+	}
+	return pre
+}
+
+// Build returns the dot separated build metadata, eg `build.5`, or an empty string if there isn't any.
+func (c Compact) Build() string {
+	tail := c.tail()
+	if i := strings.IndexByte(tail, '+'); i != -1 {
+		return tail[i+1:]
+	}
+	return ""
+}
+
+func (c Compact) String() string {
+	if c.large {
+		return c.suffix
+	}
+	return strconv.FormatInt(c.major, 10) + "." + strconv.FormatInt(c.minor, 10) + "." + strconv.FormatInt(c.patch, 10) + c.suffix
+}
+
+// CompareTo compares two instances of Compact in the same way as Version.CompareTo, without allocating unless either
+// is too large for an int. 1 is c > cx, -1 is c < cx, 0 is c == cx
+func (c Compact) CompareTo(cx Compact) int {
+	if c.large || cx.large {
+		return c.Version().CompareTo(cx.Version())
+	}
+
+	switch {
+	case c.major != cx.major:
+		return compareInt64(c.major, cx.major)
+	case c.minor != cx.minor:
+		return compareInt64(c.minor, cx.minor)
+	case c.patch != cx.patch:
+		return compareInt64(c.patch, cx.patch)
+	}
+
+	pre, prex := c.Prerelease(), cx.Prerelease()
+
+	// "When major, minor, and patch are equal, a pre-release version has lower precedence than a normal version"
+	switch {
+	case pre == "" && prex == "":
+		return 0
+	case pre == "":
+		return 1
+	case prex == "":
+		return -1
+	}
+
+	for pre != "" && prex != "" {
+		var id, idx string
+		id, pre = cutIdentifier(pre)
+		idx, prex = cutIdentifier(prex)
+
+		if comp := compareIdentifiers(id, idx); comp != 0 {
+			return comp
+		}
+	}
+
+	switch {
+	case pre != "":
+		return 1
+	case prex != "":
+		return -1
+	default:
+		return 0
+	}
+}
+
+func compareInt64(a, b int64) int {
+	switch {
+	case a > b:
+		return 1
+	case a < b:
+		return -1
+	default:
+		return 0
+	}
+}
+
+// cutIdentifier splits the first identifier from a dot separated list of them.
+func cutIdentifier(s string) (identifier, rest string) {
+	if i := strings.IndexByte(s, '.'); i != -1 {
+		return s[:i], s[i+1:]
+	}
+	return s, ""
+}
+
+// CompactSlice is a sortable slice of Compact versions.
+type CompactSlice []Compact
+
+func (s CompactSlice) Len() int {
+	return len(s)
+}
+
+func (s CompactSlice) Less(i, j int) bool {
+	return s[i].CompareTo(s[j]) == -1
+}
+
+func (s CompactSlice) Swap(i, j int) {
+	s[j], s[i] = s[i], s[j]
+}
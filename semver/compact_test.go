@@ -0,0 +1,221 @@
+package semver
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestParseCompact(t *testing.T) {
+	tests := []struct {
+		args    string
+		wantErr bool
+	}{
+		{args: "1.2.3"},
+		{args: "0.0.0"},
+		{args: "1.2.3-rc.1"},
+		{args: "1.2.3+build.5"},
+		{args: "1.2.3-rc.1+build-5.6"},
+		{args: "1.2.3-99999999999999999999"},
+		{args: "18446744073709551616.2.3"},
+		{args: "1.2.18446744073709551616-rc.1+build.5"},
+		{args: "1.2", wantErr: true},
+		{args: "1.02.3", wantErr: true},
+		{args: "1.2.3-", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.args, func(t *testing.T) {
+			got, err := ParseCompact(tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseCompact() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+
+			if got.String() != tt.args {
+				t.Errorf("Compact.String() = %v, want %v", got, tt.args)
+			}
+
+			// converting to and from a *Version must not lose anything
+			want := mkv(tt.args)
+			if v := got.Version(); !reflect.DeepEqual(v, want) {
+				t.Errorf("Compact.Version() = %#v, want %#v", v, want)
+			}
+			if c, err := want.Compact(); err != nil || c != got {
+				t.Errorf("Version.Compact() = %v, %v, want %v", c, err, got)
+			}
+			if c, err := ParseCompactBytes([]byte(tt.args)); err != nil || c != got {
+				t.Errorf("ParseCompactBytes() = %v, %v, want %v", c, err, got)
+			}
+		})
+	}
+}
+
+func TestCompact_Accessors(t *testing.T) {
+	c := MustParseCompact("1.2.3-rc.1+build.5")
+	if c.Major() != 1 || c.Minor() != 2 || c.Patch() != 3 {
+		t.Errorf("Compact core = %d.%d.%d, want 1.2.3", c.Major(), c.Minor(), c.Patch())
+	}
+	if c.Prerelease() != "rc.1" {
+		t.Errorf("Compact.Prerelease() = %v, want rc.1", c.Prerelease())
+	}
+	if c.Build() != "build.5" {
+		t.Errorf("Compact.Build() = %v, want build.5", c.Build())
+	}
+
+	c = MustParseCompact("1.2.3+build-5")
+	if c.Prerelease() != "" || c.Build() != "build-5" {
+		t.Errorf("Compact = %v, %v, want no prerelease and build-5", c.Prerelease(), c.Build())
+	}
+
+	c = MustParseCompact("1.18446744073709551616.3-rc.1+build.5")
+	if c.Major() != 1 || c.Minor() != maxInt || c.Patch() != 3 {
+		t.Errorf("Compact core = %d.%d.%d, want 1.%d.3", c.Major(), c.Minor(), c.Patch(), maxInt)
+	}
+	if c.Prerelease() != "rc.1" || c.Build() != "build.5" {
+		t.Errorf("Compact = %v, %v, want rc.1 and build.5", c.Prerelease(), c.Build())
+	}
+}
+
+func TestCompact_CompareTo(t *testing.T) {
+	// must agree with Version.CompareTo for every pair of versions
+	vs := []string{"0.0.1", "1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-beta", "1.0.0-beta.2",
+		"1.0.0-beta.11", "1.0.0-rc.1", "1.0.0", "1.0.0+build", "1.0.0-99999999999999999999", "1.0.0-100000000000000000000",
+		"1.0.0--1", "1.2.0", "1.10.0", "1.18446744073709551616.0-rc.1", "1.18446744073709551616.0", "2.0.0",
+		"18446744073709551616.0.0"}
+
+	for _, a := range vs {
+		for _, b := range vs {
+			if got, want := MustParseCompact(a).CompareTo(MustParseCompact(b)), mkv(a).CompareTo(mkv(b)); got != want {
+				t.Errorf("Compact(%s).CompareTo(%s) = %v, want %v", a, b, got, want)
+			}
+		}
+	}
+}
+
+func TestVersion_Compact(t *testing.T) {
+	// invalid versions are rejected rather than stored, as they couldn't be turned back into a *Version
+	tests := []struct {
+		version *Version
+		want    error
+	}{
+		{version: &Version{Major: -1}, want: ErrorNegativeNumber},
+		{version: &Version{Major: 1, Patch: -3, Prerelease: []string{"rc"}}, want: ErrorNegativeNumber},
+		{version: &Version{Major: 1, Prerelease: []string{"01"}}, want: ErrorLeadingZero},
+		{version: &Version{Major: 1, Build: []string{""}}, want: ErrorEmptyBuildIdentifier},
+	}
+	for _, tt := range tests {
+		t.Run(tt.version.String(), func(t *testing.T) {
+			if c, err := tt.version.Compact(); !errors.Is(err, tt.want) {
+				t.Errorf("Version.Compact() = %v, %v, want error %v", c, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseCompactBytes_Allocs(t *testing.T) {
+	x := []byte("12.345.6789")
+	if n := testing.AllocsPerRun(100, func() { ParseCompactBytes(x) }); n != 0 {
+		t.Errorf("ParseCompactBytes() allocations = %v, want 0", n)
+	}
+}
+
+func TestCompactSlice(t *testing.T) {
+	parsed := CompactSlice{MustParseCompact("1.6.3"), MustParseCompact("2.6.2"), MustParseCompact("0.3.1"), MustParseCompact("1.6.3-alpha+shldsfkjh")}
+	sort.Sort(parsed)
+
+	var got []string
+	for _, x := range parsed {
+		got = append(got, x.String())
+	}
+
+	want := []string{"0.3.1", "1.6.3-alpha+shldsfkjh", "1.6.3", "2.6.2"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Sorted slice is %v, want %v", got, want)
+	}
+}
+
+// benchmarkVersions returns n version strings, roughly a tenth of which have prereleases.
+func benchmarkVersions(n int) []string {
+	r := rand.New(rand.NewSource(1))
+	vs := make([]string, n)
+	for i := range vs {
+		vs[i] = fmt.Sprintf("%d.%d.%d", r.Intn(20), r.Intn(50), r.Intn(100))
+		if r.Intn(10) == 0 {
+			vs[i] += fmt.Sprintf("-rc.%d", r.Intn(5))
+		}
+	}
+	return vs
+}
+
+func BenchmarkSort(b *testing.B) {
+	raw := benchmarkVersions(100000)
+
+	b.Run("Slice", func(b *testing.B) {
+		vs, _ := ParseMultiple(raw)
+		x := make(Slice, len(vs))
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i += 1 {
+			copy(x, vs)
+			sort.Sort(x)
+		}
+	})
+
+	b.Run("CompactSlice", func(b *testing.B) {
+		cs := make(CompactSlice, len(raw))
+		for i, r := range raw {
+			cs[i] = MustParseCompact(r)
+		}
+		x := make(CompactSlice, len(cs))
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i += 1 {
+			copy(x, cs)
+			sort.Sort(x)
+		}
+	})
+}
+
+func BenchmarkParseAndSort(b *testing.B) {
+	// parsing and sorting a large number of versions, as you would with the tags of a repository
+	raw := benchmarkVersions(100000)
+
+	b.Run("Baseline", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i += 1 {
+			x := make(Slice, len(raw))
+			for j, r := range raw {
+				x[j], _ = parseBaseline(r)
+			}
+			sort.Sort(x)
+		}
+	})
+
+	b.Run("Slice", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i += 1 {
+			x := make(Slice, len(raw))
+			for j, r := range raw {
+				x[j] = MustParse(r)
+			}
+			sort.Sort(x)
+		}
+	})
+
+	b.Run("CompactSlice", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i += 1 {
+			x := make(CompactSlice, len(raw))
+			for j, r := range raw {
+				x[j] = MustParseCompact(r)
+			}
+			sort.Sort(x)
+		}
+	})
+}
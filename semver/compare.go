@@ -1,12 +1,21 @@
 package semver
 
 import (
-	"strconv"
+	"strings"
 )
 
 // compareVersionCore compares the major, minor and patch versions of a semantic version. 1 is v > vx, -1 is v < vx,
 // 0 is v == vx
 func compareVersionCore(v, vx *Version) int {
+	if v.large != "" || vx.large != "" {
+		for i := 0; i < 3; i += 1 {
+			if n := compareCoreNumbers(v, vx, i); n != 0 {
+				return n
+			}
+		}
+		return 0
+	}
+
 	switch {
 	case v.Major > vx.Major:
 		return 1
@@ -25,17 +34,19 @@ func compareVersionCore(v, vx *Version) int {
 	}
 }
 
+// compareNumericIdentifiers compares two numeric identifiers, which may be too large to fit in any integer type.
 func compareNumericIdentifiers(v, vx string) int {
-	vi, _ := strconv.Atoi(v)
-	vxi, _ := strconv.Atoi(vx)
+	v = strings.TrimLeft(v, "0")
+	vx = strings.TrimLeft(vx, "0")
 
+	// without leading zeros, a longer number is always larger
 	switch {
-	case vi > vxi:
+	case len(v) > len(vx):
 		return 1
-	case vi < vxi:
+	case len(v) < len(vx):
 		return -1
 	default:
-		return 0
+		return compareAlphanumericIdentifiers(v, vx)
 	}
 }
 
@@ -131,3 +142,58 @@ func (v *Version) CompareTo(vx *Version) int {
 		c += 1
 	}
 }
+
+// Equal returns true if v and vx have the same precedence, ignoring build metadata, so `1.0.0+a` equals `1.0.0+b`.
+func (v *Version) Equal(vx *Version) bool {
+	return v.CompareTo(vx) == 0
+}
+
+// StrictEqual returns true if v and vx are identical, including their build metadata.
+func (v *Version) StrictEqual(vx *Version) bool {
+	return Compare(v, vx) == 0
+}
+
+// LessThan returns true if v has a lower precedence than vx.
+func (v *Version) LessThan(vx *Version) bool {
+	return v.CompareTo(vx) < 0
+}
+
+// GreaterThan returns true if v has a higher precedence than vx.
+func (v *Version) GreaterThan(vx *Version) bool {
+	return v.CompareTo(vx) > 0
+}
+
+// Compare compares a and b in the same way as a.CompareTo(b), but breaks ties using build metadata, so that it only
+// returns 0 if the versions are identical. A version without build metadata comes first, and build identifiers are
+// otherwise compared in the same way as prerelease identifiers. This gives versions a total order, as required to
+// sort them deterministically, and follows the same conventions as the standard library's cmp.Compare, so it can be
+// used with slices.SortFunc.
+func Compare(a, b *Version) int {
+	if c := a.CompareTo(b); c != 0 {
+		return c
+	}
+
+	switch {
+	case len(a.Build) == 0 && len(b.Build) == 0:
+		return 0
+	case len(a.Build) == 0:
+		return -1
+	case len(b.Build) == 0:
+		return 1
+	}
+
+	for i := 0; i < len(a.Build) && i < len(b.Build); i += 1 {
+		if c := compareIdentifiers(a.Build[i], b.Build[i]); c != 0 {
+			return c
+		}
+	}
+
+	switch {
+	case len(a.Build) < len(b.Build):
+		return -1
+	case len(a.Build) > len(b.Build):
+		return 1
+	default:
+		return 0
+	}
+}
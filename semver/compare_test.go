@@ -41,6 +41,10 @@ func TestVersion_CompareToEqual(t *testing.T) {
 		{fields: mkv("1.0.0-alpha"), args: mkv("1.0.0-alpha"), want: 0},
 		{fields: mkv("1.0.0-alpha.1"), args: mkv("1.0.0-alpha.1"), want: 0},
 		{fields: mkv("1.0.0-1"), args: mkv("1.0.0-1"), want: 0},
+		{fields: mkv("1.0.0-99999999999999999999"), args: mkv("1.0.0-99999999999999999999"), want: 0},
+
+		// version core numbers too large for an int
+		{fields: mkv("18446744073709551616.0.0"), args: mkv("18446744073709551616.0.0+build"), want: 0},
 
 		// build is ignored
 		{fields: mkv("1.0.0"), args: mkv("1.0.0+build.1.2.3"), want: 0},
@@ -69,12 +73,26 @@ func TestVersion_CompareToGreaterThan(t *testing.T) {
 		{fields: mkv("1.0.0-10"), args: mkv("1.0.0-1"), want: 1},
 		{fields: mkv("1.0.0-alpha.3"), args: mkv("1.0.0-alpha.1"), want: 1},
 
+		// prerelease precedence with numeric ids too large for an int
+		{fields: mkv("1.0.0-99999999999999999999"), args: mkv("1.0.0-0"), want: 1},
+		{fields: mkv("1.0.0-99999999999999999999"), args: mkv("1.0.0-9223372036854775807"), want: 1},
+		{fields: mkv("1.0.0-20240101120000123456789"), args: mkv("1.0.0-20231231235959999999999"), want: 1},
+		{fields: mkv("1.0.0-rc.100000000000000000000"), args: mkv("1.0.0-rc.99999999999999999999"), want: 1},
+		{fields: mkv("1.0.0-a"), args: mkv("1.0.0-99999999999999999999"), want: 1},
+
+		// version core numbers too large for an int
+		{fields: mkv("18446744073709551617.0.0"), args: mkv("18446744073709551616.0.0"), want: 1},
+		{fields: mkv("18446744073709551616.0.0"), args: mkv("9223372036854775807.0.0"), want: 1},
+		{fields: mkv("1.100000000000000000000.0"), args: mkv("1.99999999999999999999.5"), want: 1},
+		{fields: mkv("1.2.18446744073709551616"), args: mkv("1.2.3"), want: 1},
+
 		// prerelease precedence with alphanumeric identifier
 		{fields: mkv("1.0.0-1"), args: mkv("1.0.0-0"), want: 1},
 		{fields: mkv("1.0.0-Z"), args: mkv("1.0.0-A"), want: 1},
 		{fields: mkv("1.0.0-Z"), args: mkv("1.0.0-1"), want: 1},
 		{fields: mkv("1.0.0-alpha-3"), args: mkv("1.0.0-alpha-1"), want: 1},
 		{fields: mkv("1.0.0-alpha-3"), args: mkv("1.0.0-alpha-100"), want: 1},
+		{fields: mkv("1.0.0--1"), args: mkv("1.0.0-1"), want: 1},
 	}.Run(t)
 }
 
@@ -90,3 +108,54 @@ func TestVersion_CompareToLessThan(t *testing.T) {
 		{fields: mkv("1.0.0-rc.1"), args: mkv("1.0.0"), want: -1},
 	}.Run(t)
 }
+
+func TestVersion_Equality(t *testing.T) {
+	tests := []struct {
+		a, b                                      string
+		equal, strictEqual, lessThan, greaterThan bool
+	}{
+		{a: "1.0.0", b: "1.0.0", equal: true, strictEqual: true},
+		{a: "1.0.0+a", b: "1.0.0+b", equal: true},
+		{a: "1.0.0", b: "1.0.0+b", equal: true},
+		{a: "1.0.0-rc.1+a", b: "1.0.0-rc.1+a", equal: true, strictEqual: true},
+		{a: "1.0.0-rc.1", b: "1.0.0", lessThan: true},
+		{a: "1.0.1+a", b: "1.0.0+b", greaterThan: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+" "+tt.b, func(t *testing.T) {
+			a, b := mkv(tt.a), mkv(tt.b)
+			if got := a.Equal(b); got != tt.equal {
+				t.Errorf("Equal() = %v, want %v", got, tt.equal)
+			}
+			if got := a.StrictEqual(b); got != tt.strictEqual {
+				t.Errorf("StrictEqual() = %v, want %v", got, tt.strictEqual)
+			}
+			if got := a.LessThan(b); got != tt.lessThan {
+				t.Errorf("LessThan() = %v, want %v", got, tt.lessThan)
+			}
+			if got := a.GreaterThan(b); got != tt.greaterThan {
+				t.Errorf("GreaterThan() = %v, want %v", got, tt.greaterThan)
+			}
+		})
+	}
+}
+
+func TestCompare(t *testing.T) {
+	// in ascending order
+	ordered := []string{"1.0.0-rc.1", "1.0.0-rc.1+1", "1.0.0", "1.0.0+1", "1.0.0+2", "1.0.0+10", "1.0.0+10.1",
+		"1.0.0+a", "1.0.0+a.b", "1.0.0+b", "1.0.1"}
+
+	for i, a := range ordered {
+		for j, b := range ordered {
+			want := 0
+			if i < j {
+				want = -1
+			} else if i > j {
+				want = 1
+			}
+			if got := Compare(mkv(a), mkv(b)); got != want {
+				t.Errorf("Compare(%s, %s) = %v, want %v", a, b, got, want)
+			}
+		}
+	}
+}
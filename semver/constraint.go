@@ -0,0 +1,165 @@
+package semver
+
+import "strings"
+
+// Constraint is a parsed version range, for example `^2.0.0 <2.2.0 || >2.3.0`. Once parsed, a Constraint can be checked
+// against any number of versions without the range being parsed again.
+type Constraint struct {
+	options ConstraintOptions
+
+	// sets holds each of the `||` separated comparator sets. A version satisfies the constraint if it satisfies every
+	// comparator in at least one set.
+	sets []comparatorSet
+}
+
+// ConstraintOptions changes how a Constraint is matched against versions.
+type ConstraintOptions struct {
+	// IncludePrerelease allows any prerelease version to satisfy a comparator set it is within the bounds of. By
+	// default, a prerelease version only satisfies a comparator set if one of its comparators has a prerelease with the
+	// same major, minor and patch version, for example `>=1.0.0-beta.2` matches `1.0.0-beta.3` but not `1.1.0-beta.1`.
+	IncludePrerelease bool
+
+	// Dialect is the syntax that the constraint is written in. Regardless of the dialect, the String method of a
+	// Constraint always uses the default syntax.
+	Dialect Dialect
+}
+
+// comparatorSet is a set of space separated comparators, all of which must be satisfied.
+type comparatorSet []*comparator
+
+func (s comparatorSet) check(v *Version, includePrerelease bool) bool {
+	for _, c := range s {
+		if !c.check(v) {
+			return false
+		}
+	}
+
+	if len(v.Prerelease) == 0 || includePrerelease {
+		return true
+	}
+
+	// prereleases are only matched when a comparator opts into prereleases of the same version
+	for _, c := range s {
+		if c.allowsPrereleaseOf(v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s comparatorSet) String() string {
+	x := make([]string, len(s))
+	for i, c := range s {
+		x[i] = c.String()
+	}
+	return strings.Join(x, " ")
+}
+
+// ParseConstraint parses a version range using the same syntax as Filter.
+func ParseConstraint(in string) (*Constraint, error) {
+	return parseFilter(in, ConstraintOptions{})
+}
+
+// ParseConstraintWithOptions is like ParseConstraint but allows the matching behaviour of the Constraint to be
+// changed.
+func ParseConstraintWithOptions(in string, options ConstraintOptions) (*Constraint, error) {
+	return parseFilter(in, options)
+}
+
+// MustParseConstraint is like ParseConstraint but panics if the range cannot be parsed.
+func MustParseConstraint(in string) *Constraint {
+	c, err := ParseConstraint(in)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// Check returns true if v satisfies the constraint.
+func (c *Constraint) Check(v *Version) bool {
+	for _, set := range c.sets {
+		if set.check(v, c.options.IncludePrerelease) {
+			return true
+		}
+	}
+	return false
+}
+
+// Filter removes any versions from options that do not satisfy the constraint. Like the package-level Filter, this is
+// performed in place, reusing the backing array of options.
+func (c *Constraint) Filter(options Slice) Slice {
+	var n int
+	for _, x := range options {
+		// true denotes an item to keep
+		if c.Check(x) {
+			options[n] = x
+			n += 1
+		}
+	}
+	return options[:n]
+}
+
+// FilterCopy returns the versions in options that satisfy the constraint, in the same order. Unlike Filter, options is
+// not modified.
+func (c *Constraint) FilterCopy(options Slice) Slice {
+	var x Slice
+	for _, v := range options {
+		if c.Check(v) {
+			x = append(x, v)
+		}
+	}
+	return x
+}
+
+// FilterIndices returns the indices of the versions in options that satisfy the constraint, in ascending order.
+// options is not modified.
+func (c *Constraint) FilterIndices(options Slice) []int {
+	var x []int
+	for i, v := range options {
+		if c.Check(v) {
+			x = append(x, i)
+		}
+	}
+	return x
+}
+
+// FilterSeq returns an iterator over the versions yielded by seq that satisfy the constraint. Versions are checked as
+// they are consumed, so seq is never held in memory. The signatures of both seq and the result match iter.Seq, so it
+// can be used with range-over-func.
+func (c *Constraint) FilterSeq(seq func(yield func(*Version) bool)) func(yield func(*Version) bool) {
+	return func(yield func(*Version) bool) {
+		seq(func(v *Version) bool {
+			if !c.Check(v) {
+				return true
+			}
+			return yield(v)
+		})
+	}
+}
+
+// FilterChan returns a channel that receives each version sent on in that satisfies the constraint. The returned
+// channel is closed once in is closed. The output channel must be drained, otherwise the goroutine doing the
+// filtering will not exit.
+func (c *Constraint) FilterChan(in <-chan *Version) <-chan *Version {
+	out := make(chan *Version)
+	go func() {
+		defer close(out)
+		for v := range in {
+			if c.Check(v) {
+				out <- v
+			}
+		}
+	}()
+	return out
+}
+
+// String returns the canonical form of the constraint. Exact matches are written without a leading `=`, comparators
+// are separated by a single space and comparator sets by ` || `.
+func (c *Constraint) String() string {
+	x := make([]string, len(c.sets))
+	for i, set := range c.sets {
+		x[i] = set.String()
+	}
+	return strings.Join(x, " || ")
+}
@@ -0,0 +1,228 @@
+package semver
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseConstraint(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    string
+		want    string
+		wantErr bool
+	}{
+		{args: "^2.2.1", want: "^2.2.1"},
+		{args: "=1.0.0", want: "1.0.0"},
+		{args: "1.0.0-rc.1", want: "1.0.0-rc.1"},
+		{args: ">=1.0.0 <2.0.0", want: ">=1.0.0 <2.0.0"},
+		{args: "^2.0.0 <2.2.0||>2.3.0", want: "^2.0.0 <2.2.0 || >2.3.0"},
+		{args: "~1.2.3 || =4.0.0 ||   5.0.0", want: "~1.2.3 || 4.0.0 || 5.0.0"},
+		{args: "1.2.3 - 2.3.4", want: ">=1.2.3 <=2.3.4"},
+		{args: "1.2 - 2", want: ">=1.2.0 <3.0.0-0"},
+		{args: "1.2 - 18446744073709551616", want: ">=1.2.0 <18446744073709551617.0.0-0"},
+		{args: "1.2.x", want: ">=1.2.0 <1.3.0-0"},
+		{args: "18446744073709551616.x", want: ">=18446744073709551616.0.0 <18446744073709551617.0.0-0"},
+		{args: "^0.0", want: ">=0.0.0 <0.1.0-0"},
+		{args: "", want: "*"},
+		{args: "* || X", want: "* || *"},
+		{args: "1.2.3-alpha.x.1", want: "1.2.3-alpha.x.1"},
+		{args: "1.2.3+b.x.1", want: "1.2.3+b.x.1"},
+
+		{name: "Empty set", args: "1.0.0 ||", wantErr: true},
+		{name: "Double space", args: "1.0.0  2.0.0", wantErr: true},
+		{name: "Invalid prefix", args: "z1.0.0", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseConstraint(tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseConstraint() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && got.String() != tt.want {
+				t.Errorf("ParseConstraint().String() = %v, want %v", got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestConstraint_Check(t *testing.T) {
+	c := MustParseConstraint("^2.0.0 <2.2.0 || >2.3.0")
+
+	for _, x := range []string{"2.0.0", "2.1.9", "2.3.1", "3.0.0"} {
+		if !c.Check(mkv(x)) {
+			t.Errorf("Constraint.Check(%s) = false, want true", x)
+		}
+	}
+
+	for _, x := range []string{"1.9.9", "2.2.0", "2.3.0", "2.4.0-rc.1"} {
+		if c.Check(mkv(x)) {
+			t.Errorf("Constraint.Check(%s) = true, want false", x)
+		}
+	}
+}
+
+func TestConstraint_CheckLargeNumbers(t *testing.T) {
+	tests := []struct {
+		constraint string
+		version    string
+		want       bool
+	}{
+		{constraint: "^18446744073709551616.2.3", version: "18446744073709551616.9.0", want: true},
+		{constraint: "^18446744073709551616.2.3", version: "18446744073709551617.0.0", want: false},
+		{constraint: "^0.18446744073709551616.3", version: "0.18446744073709551617.0", want: false},
+		{constraint: "~>18446744073709551616.2", version: "18446744073709551616.9.0", want: true},
+		{constraint: "~>18446744073709551616.2", version: "18446744073709551617.0.0", want: false},
+		{constraint: "~1.18446744073709551616.3", version: "1.18446744073709551616.9", want: true},
+		{constraint: "~1.18446744073709551616.3", version: "1.18446744073709551617.0", want: false},
+		{constraint: "^18446744073709551616", version: "18446744073709551616.5.0", want: true},
+		{constraint: "^18446744073709551616", version: "18446744073709551617.0.0", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.constraint+" "+tt.version, func(t *testing.T) {
+			if got := MustParseConstraint(tt.constraint).Check(mkv(tt.version)); got != tt.want {
+				t.Errorf("Constraint.Check() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConstraint_Filter(t *testing.T) {
+	// a Constraint must give the same results as Filter each time it's used
+	c := MustParseConstraint("^2.2.1")
+	want := mustParseMultiple("2.2.1", "2.3.0", "2.4.0", "2.4.1", "2.4.2")
+
+	for i := 0; i < 2; i += 1 {
+		got := c.Filter(ft("").options)
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Constraint.Filter() = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestConstraint_IncludePrerelease(t *testing.T) {
+	tests := []struct {
+		constraint string
+		version    string
+		want       bool
+	}{
+		{constraint: ">=1.0.0", version: "1.1.0-beta.1", want: true},
+		{constraint: "^1.2.3", version: "1.9.0-rc.1", want: true},
+		{constraint: "^1.2.3", version: "2.0.0-rc.1", want: false},
+		{constraint: "^1.2.3", version: "1.2.3-rc.1", want: false},
+		{constraint: "~1.2.3", version: "1.3.0-rc.1", want: false},
+		{constraint: "1.2.x", version: "1.2.0-rc.1", want: true},
+		{constraint: "1.2.x", version: "1.3.0-rc.1", want: false},
+		{constraint: ">=1.2", version: "1.2.0-rc.1", want: true},
+		{constraint: ">1.2", version: "1.3.0-rc.1", want: true},
+		{constraint: "<1.2", version: "1.2.0-rc.1", want: false},
+		{constraint: "1.0 - 2", version: "1.0.0-alpha", want: true},
+		{constraint: "1.0 - 2", version: "3.0.0-alpha", want: false},
+		{constraint: "*", version: "0.0.1-alpha", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.constraint+" "+tt.version, func(t *testing.T) {
+			c, err := ParseConstraintWithOptions(tt.constraint, ConstraintOptions{IncludePrerelease: true})
+			if err != nil {
+				t.Fatalf("ParseConstraintWithOptions() error = %v", err)
+			}
+			if got := c.Check(mkv(tt.version)); got != tt.want {
+				t.Errorf("Constraint.Check() = %v, want %v", got, tt.want)
+			}
+			if MustParseConstraint(tt.constraint).Check(mkv(tt.version)) {
+				t.Errorf("Constraint.Check() without IncludePrerelease = true, want false")
+			}
+		})
+	}
+}
+
+func TestConstraint_FilterCopy(t *testing.T) {
+	c := MustParseConstraint("^2.2.1")
+	options := ft("").options
+	original := append(Slice(nil), options...)
+
+	got := c.FilterCopy(options)
+	if want := mustParseMultiple("2.2.1", "2.3.0", "2.4.0", "2.4.1", "2.4.2"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Constraint.FilterCopy() = %v, want %v", got, want)
+	}
+	if !reflect.DeepEqual(options, original) {
+		t.Errorf("Constraint.FilterCopy() modified options")
+	}
+
+	if got := c.FilterCopy(mustParseMultiple("1.0.0")); len(got) != 0 {
+		t.Errorf("Constraint.FilterCopy() = %v, want []", got)
+	}
+}
+
+func TestConstraint_FilterIndices(t *testing.T) {
+	options, _ := ParseMultiple([]string{"1.0.0", "2.2.1", "3.0.0", "2.4.0"})
+	original := append(Slice(nil), options...)
+
+	got := MustParseConstraint("^2.2.1").FilterIndices(options)
+	if want := []int{1, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Constraint.FilterIndices() = %v, want %v", got, want)
+	}
+	if !reflect.DeepEqual(options, original) {
+		t.Errorf("Constraint.FilterIndices() modified options")
+	}
+}
+
+func TestConstraint_FilterSeq(t *testing.T) {
+	options := ft("").options
+	seq := func(yield func(*Version) bool) {
+		for _, v := range options {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+
+	c := MustParseConstraint("^2.2.1")
+
+	var got Slice
+	c.FilterSeq(seq)(func(v *Version) bool {
+		got = append(got, v)
+		return true
+	})
+	if want := mustParseMultiple("2.2.1", "2.3.0", "2.4.0", "2.4.1", "2.4.2"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Constraint.FilterSeq() = %v, want %v", got, want)
+	}
+
+	// stopping early must stop the underlying iterator
+	got = nil
+	c.FilterSeq(seq)(func(v *Version) bool {
+		got = append(got, v)
+		return len(got) < 2
+	})
+	if want := mustParseMultiple("2.2.1", "2.3.0"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Constraint.FilterSeq() = %v, want %v", got, want)
+	}
+}
+
+func TestConstraint_FilterChan(t *testing.T) {
+	in := make(chan *Version)
+	go func() {
+		for _, v := range ft("").options {
+			in <- v
+		}
+		close(in)
+	}()
+
+	var got Slice
+	for v := range MustParseConstraint("^2.2.1").FilterChan(in) {
+		got = append(got, v)
+	}
+	if want := mustParseMultiple("2.2.1", "2.3.0", "2.4.0", "2.4.1", "2.4.2"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Constraint.FilterChan() = %v, want %v", got, want)
+	}
+}
+
+func TestMustParseConstraint(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("MustParseConstraint() did not panic on an invalid constraint")
+		}
+	}()
+	MustParseConstraint("z1.0.0")
+}
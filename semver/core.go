@@ -0,0 +1,114 @@
+package semver
+
+import (
+	"strconv"
+	"strings"
+)
+
+// maxInt is the largest possible int. A major, minor or patch version that is larger than this is stored as maxInt,
+// with its digits in Version.large.
+const maxInt = int(^uint(0) >> 1)
+
+// coreField returns the major (0), minor (1) or patch (2) version field of v.
+func (v *Version) coreField(i int) *int {
+	switch i {
+	case 0:
+		return &v.Major
+	case 1:
+		return &v.Minor
+	default:
+		return &v.Patch
+	}
+}
+
+// coreNumber returns the digits of the major (0), minor (1) or patch (2) version of v, which can be any size.
+func (v *Version) coreNumber(i int) string {
+	if n := *v.coreField(i); n != maxInt || v.largeDigits(i) == "" {
+		return strconv.Itoa(n)
+	}
+	return v.largeDigits(i)
+}
+
+// largeDigits returns the digits stored in v.large for the major (0), minor (1) or patch (2) version, or an empty
+// string if that number fits in an int.
+func (v *Version) largeDigits(i int) string {
+	x := v.large
+	for ; i > 0 && x != ""; i -= 1 {
+		x = x[strings.IndexByte(x, '.')+1:]
+	}
+	if n := strings.IndexByte(x, '.'); n != -1 {
+		x = x[:n]
+	}
+	return x
+}
+
+// setCoreNumber sets the major (0), minor (1) or patch (2) version of v from a string of digits, which can be any
+// size.
+func (v *Version) setCoreNumber(i int, digits string) {
+	n, err := strconv.Atoi(digits)
+	if err == nil {
+		digits = ""
+	} else {
+		// digits only ever contains digits, so the only way this can fail is if the number doesn't fit in an int
+		n = maxInt
+	}
+	*v.coreField(i) = n
+
+	if v.large == "" && digits == "" {
+		return
+	}
+
+	large := [3]string{v.largeDigits(0), v.largeDigits(1), v.largeDigits(2)}
+	large[i] = digits
+	if large == [3]string{} {
+		v.large = ""
+	} else {
+		v.large = large[0] + "." + large[1] + "." + large[2]
+	}
+}
+
+// Overflows returns true if the major, minor or patch version of v is too large to fit in an int. The field for that
+// number is set to the largest possible int, but String and comparisons use the full number.
+func (v *Version) Overflows() bool {
+	if v.large == "" {
+		return false
+	}
+	for i := 0; i < 3; i += 1 {
+		if *v.coreField(i) == maxInt && v.largeDigits(i) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// compareCoreNumbers compares the major (0), minor (1) or patch (2) versions of v and vx. 1 is v > vx, -1 is v < vx,
+// 0 is v == vx
+func compareCoreNumbers(v, vx *Version, i int) int {
+	n, nx := *v.coreField(i), *vx.coreField(i)
+	switch {
+	case n > nx:
+		return 1
+	case n < nx:
+		return -1
+	case n != maxInt:
+		return 0
+	default:
+		return compareNumericIdentifiers(v.coreNumber(i), vx.coreNumber(i))
+	}
+}
+
+// core returns the version core of v, without any prerelease or build metadata.
+func (v *Version) core() *Version {
+	return &Version{Major: v.Major, Minor: v.Minor, Patch: v.Patch, large: v.large}
+}
+
+// nextCore returns the lowest release with a higher major (0), minor (1) or patch (2) version than v, eg `1.3.0` for
+// `1.2.3` and 1.
+func (v *Version) nextCore(i int) *Version {
+	x := v.core()
+	for n := i + 1; n < 3; n += 1 {
+		x.setCoreNumber(n, "0")
+	}
+	x.setCoreNumber(i, incrementNumericIdentifier(v.coreNumber(i)))
+	return x
+}
@@ -0,0 +1,34 @@
+package semver
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestVersion_Overflows(t *testing.T) {
+	for x, want := range map[string]bool{"1.2.3": false, "18446744073709551616.0.0": true, "1.2.18446744073709551616-rc.1": true} {
+		if got := mkv(x).Overflows(); got != want {
+			t.Errorf("Version(%s).Overflows() = %v, want %v", x, got, want)
+		}
+	}
+
+	// the digits are only used while the field is left at the largest int
+	v := mkv("18446744073709551616.2.3")
+	v.Major = 5
+	if v.Overflows() {
+		t.Errorf("Version.Overflows() = true after setting Major, want false")
+	}
+	if got, want := v.String(), "5.2.3"; got != want {
+		t.Errorf("Version.String() = %v, want %v", got, want)
+	}
+}
+
+func TestVersion_LargeEqual(t *testing.T) {
+	a, b := mkv("1.18446744073709551616.3-rc.1"), mkv("1.18446744073709551616.3-rc.1")
+	if !reflect.DeepEqual(a, b) {
+		t.Errorf("versions parsed from the same string are not equal")
+	}
+	if c := mkv("1.18446744073709551617.3-rc.1"); reflect.DeepEqual(a, c) {
+		t.Errorf("versions with different large numbers are equal")
+	}
+}
@@ -0,0 +1,97 @@
+package semver
+
+import "strings"
+
+// Dialect selects the syntax that a constraint is written in. Every dialect understands the same comparison operators
+// (`^ ~ ~> > >= < <= = !=`), wildcards and hyphen ranges, but they differ in how comparators are separated and what
+// some of them mean.
+type Dialect uint8
+
+const (
+	// DialectNPM is the default syntax, as used by Filter. Comparators are separated by spaces and comparator sets by
+	// `||`. A version without an operator is an exact match, and partial versions are X-ranges.
+	DialectNPM Dialect = iota
+	// DialectCargo is the syntax of Rust's Cargo. Comparators are separated by commas, and a version without an
+	// operator is the same as `^`.
+	DialectCargo
+	// DialectRuby is the syntax of RubyGems and Bundler. Comparators are separated by commas, and partial versions
+	// have any missing components filled with zeros except when used with `~>`.
+	DialectRuby
+	// DialectComposer is the syntax of PHP's Composer. Comparators are separated by commas or spaces and comparator
+	// sets by `||` or `|`. `~` is the same as `~>`, and partial versions have any missing components filled with zeros
+	// except when used with `^` and `~`.
+	DialectComposer
+)
+
+func (d Dialect) String() string {
+	switch d {
+	case DialectNPM:
+		return "npm"
+	case DialectCargo:
+		return "cargo"
+	case DialectRuby:
+		return "ruby"
+	case DialectComposer:
+		return "composer"
+	default:
+		return "unknown"
+	}
+}
+
+// splitSets splits a filter into its comparator sets.
+func (d Dialect) splitSets(filter string) []string {
+	switch d {
+	case DialectCargo, DialectRuby:
+		return []string{filter}
+	case DialectComposer:
+		return strings.Split(strings.Replace(filter, "||", "|", -1), "|")
+	default:
+		return strings.Split(filter, "||")
+	}
+}
+
+// splitComparators splits a comparator set into the raw filters it contains. Empty raw filters, such as those
+// produced by two consecutive separators, are returned as empty strings.
+func (d Dialect) splitComparators(filter string) []string {
+	if d == DialectNPM {
+		return strings.Split(filter, " ")
+	}
+
+	var rawFilters []string
+	for _, part := range strings.Split(filter, ",") {
+		fields := strings.Fields(part)
+		if len(fields) == 0 {
+			fields = []string{""}
+		}
+		rawFilters = append(rawFilters, fields...)
+	}
+	return rawFilters
+}
+
+// operator returns the operator with the same meaning as prefix in the default syntax. A missing prefix is resolved
+// to `=` or `^`.
+func (d Dialect) operator(prefix string) string {
+	switch {
+	case prefix == "" && d == DialectCargo:
+		return "^"
+	case prefix == "":
+		return "="
+	case prefix == "~" && d == DialectComposer:
+		return "~>"
+	default:
+		return prefix
+	}
+}
+
+// fillsPartials returns true if a partial version used with prefix (as returned by operator) should have any missing
+// components filled with zeros rather than being treated as an X-range.
+func (d Dialect) fillsPartials(prefix string) bool {
+	switch prefix {
+	case "!=":
+		return true
+	case "^", "~", "~>":
+		return false
+	default:
+		return d == DialectRuby || d == DialectComposer
+	}
+}
@@ -0,0 +1,80 @@
+package semver
+
+import "testing"
+
+func TestParseConstraintWithOptions_Dialect(t *testing.T) {
+	tests := []struct {
+		dialect Dialect
+		args    string
+		want    string
+		wantErr bool
+	}{
+		{dialect: DialectNPM, args: "!=1.2.3", want: "!=1.2.3"},
+		{dialect: DialectNPM, args: "!=1.2", want: "!=1.2.0"},
+		{dialect: DialectNPM, args: "~>1.2", want: ">=1.2.0 <2.0.0-0"},
+		{dialect: DialectNPM, args: "~>1", want: ">=1.0.0 <2.0.0-0"},
+		{dialect: DialectNPM, args: "~>1.2.3", want: "~1.2.3"},
+		{dialect: DialectNPM, args: "> 2.3.0", want: ">2.3.0"},
+		{dialect: DialectNPM, args: ">= 1.2 < 1.5", want: ">=1.2.0 <1.5.0-0"},
+		{dialect: DialectNPM, args: "!=1.x", wantErr: true},
+		{dialect: DialectNPM, args: "!=", wantErr: true},
+		{dialect: DialectNPM, args: ">=1.2, <1.5", wantErr: true},
+		{dialect: DialectNPM, args: ">=  1.2", wantErr: true},
+
+		{dialect: DialectCargo, args: ">=1.2, <1.5", want: ">=1.2.0 <1.5.0-0"},
+		{dialect: DialectCargo, args: ">= 1.2,<1.5", want: ">=1.2.0 <1.5.0-0"},
+		{dialect: DialectCargo, args: "1.2.3", want: "^1.2.3"},
+		{dialect: DialectCargo, args: "1.2", want: ">=1.2.0 <2.0.0-0"},
+		{dialect: DialectCargo, args: "=1.2.3", want: "1.2.3"},
+		{dialect: DialectCargo, args: "~1.2", want: ">=1.2.0 <1.3.0-0"},
+		{dialect: DialectCargo, args: "*", want: "*"},
+		{dialect: DialectCargo, args: ">=1.2,,<1.5", wantErr: true},
+		{dialect: DialectCargo, args: "^1.2 || ^2", wantErr: true},
+
+		{dialect: DialectRuby, args: "~> 1.2", want: ">=1.2.0 <2.0.0-0"},
+		{dialect: DialectRuby, args: "~> 1.2.3", want: "~1.2.3"},
+		{dialect: DialectRuby, args: ">= 1.2, < 1.5", want: ">=1.2.0 <1.5.0"},
+		{dialect: DialectRuby, args: "~> 1.2, != 1.4", want: ">=1.2.0 <2.0.0-0 !=1.4.0"},
+		{dialect: DialectRuby, args: "1.2", want: "1.2.0"},
+		{dialect: DialectRuby, args: "> 1", want: ">1.0.0"},
+
+		{dialect: DialectComposer, args: "~1.2", want: ">=1.2.0 <2.0.0-0"},
+		{dialect: DialectComposer, args: "~1.2.3", want: "~1.2.3"},
+		{dialect: DialectComposer, args: "^1.2", want: ">=1.2.0 <2.0.0-0"},
+		{dialect: DialectComposer, args: ">=1.2 <1.5", want: ">=1.2.0 <1.5.0"},
+		{dialect: DialectComposer, args: ">=1.2,<1.5 | ^2.0.0", want: ">=1.2.0 <1.5.0 || ^2.0.0"},
+		{dialect: DialectComposer, args: "1.0.0 || 2.0.0", want: "1.0.0 || 2.0.0"},
+		{dialect: DialectComposer, args: "1.2.*", want: ">=1.2.0 <1.3.0-0"},
+		{dialect: DialectComposer, args: "1.0 - 2.0", want: ">=1.0.0 <2.1.0-0"},
+		{dialect: DialectComposer, args: "1.0.0 |", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.dialect.String()+" "+tt.args, func(t *testing.T) {
+			got, err := ParseConstraintWithOptions(tt.args, ConstraintOptions{Dialect: tt.dialect})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseConstraintWithOptions() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && got.String() != tt.want {
+				t.Errorf("ParseConstraintWithOptions().String() = %v, want %v", got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestConstraint_CheckNotEqual(t *testing.T) {
+	c := MustParseConstraint("^1.2.0 !=1.3.0 !=1.4.0-rc.1")
+
+	for _, x := range []string{"1.2.0", "1.2.9", "1.3.1", "1.4.0"} {
+		if !c.Check(mkv(x)) {
+			t.Errorf("Constraint.Check(%s) = false, want true", x)
+		}
+	}
+
+	// excluding a prerelease must not allow other prereleases to match
+	for _, x := range []string{"1.3.0", "1.4.0-rc.1", "1.4.0-rc.2", "2.0.0"} {
+		if c.Check(mkv(x)) {
+			t.Errorf("Constraint.Check(%s) = true, want false", x)
+		}
+	}
+}
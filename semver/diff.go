@@ -0,0 +1,120 @@
+package semver
+
+// Difference is the kind of change between two versions, as returned by Diff.
+type Difference uint8
+
+const (
+	// DifferenceNone means that the versions are identical, including their build metadata.
+	DifferenceNone Difference = iota
+	// DifferenceMajor means that the major version changed, eg `1.2.3` and `2.0.0`.
+	DifferenceMajor
+	// DifferenceMinor means that the minor version changed, eg `1.2.3` and `1.3.0`.
+	DifferenceMinor
+	// DifferencePatch means that the patch version changed, eg `1.2.3` and `1.2.4`.
+	DifferencePatch
+	// DifferencePremajor means that the major version changed and the newer version is a prerelease, eg `1.2.3` and
+	// `2.0.0-rc.1`.
+	DifferencePremajor
+	// DifferencePreminor means that the minor version changed and the newer version is a prerelease, eg `1.2.3` and
+	// `1.3.0-rc.1`.
+	DifferencePreminor
+	// DifferencePrepatch means that the patch version changed and the newer version is a prerelease, eg `1.2.3` and
+	// `1.2.4-rc.1`.
+	DifferencePrepatch
+	// DifferencePrerelease means that only the prerelease changed, eg `1.2.3-rc.1` and `1.2.3-rc.2`.
+	DifferencePrerelease
+	// DifferenceBuildOnly means that only the build metadata changed, eg `1.2.3+1` and `1.2.3+2`.
+	DifferenceBuildOnly
+)
+
+func (d Difference) String() string {
+	switch d {
+	case DifferenceNone:
+		return "none"
+	case DifferenceMajor:
+		return "major"
+	case DifferenceMinor:
+		return "minor"
+	case DifferencePatch:
+		return "patch"
+	case DifferencePremajor:
+		return "premajor"
+	case DifferencePreminor:
+		return "preminor"
+	case DifferencePrepatch:
+		return "prepatch"
+	case DifferencePrerelease:
+		return "prerelease"
+	case DifferenceBuildOnly:
+		return "build-only"
+	default:
+		return "unknown"
+	}
+}
+
+// Diff returns the kind of change between a and b, in the same way as node-semver's `diff`. The order of a and b
+// doesn't matter.
+//
+// Going from a prerelease to a release is classified by the release it leads to, so `1.0.0-rc.1` to `1.0.0` is a
+// major change and `1.2.3-rc.1` to `1.2.3` is a patch change. Going to a prerelease is one of the `pre` kinds, unless
+// both versions are prereleases of the same version.
+func Diff(a, b *Version) Difference {
+	comparison := a.CompareTo(b)
+	if comparison == 0 {
+		if !equalIdentifiers(a.Build, b.Build) {
+			return DifferenceBuildOnly
+		}
+		return DifferenceNone
+	}
+
+	high, low := a, b
+	if comparison < 0 {
+		high, low = b, a
+	}
+	highIsPrerelease, lowIsPrerelease := len(high.Prerelease) != 0, len(low.Prerelease) != 0
+
+	if lowIsPrerelease && !highIsPrerelease {
+		// 1.0.0-1 to 1.0.0, 1.1.1 or 2.0.0 are all major changes
+		if low.Minor == 0 && low.Patch == 0 {
+			return DifferenceMajor
+		}
+		if compareVersionCore(low, high) == 0 {
+			if low.Patch == 0 {
+				return DifferenceMinor
+			}
+			return DifferencePatch
+		}
+	}
+
+	switch {
+	case compareCoreNumbers(a, b, 0) != 0:
+		if highIsPrerelease {
+			return DifferencePremajor
+		}
+		return DifferenceMajor
+	case compareCoreNumbers(a, b, 1) != 0:
+		if highIsPrerelease {
+			return DifferencePreminor
+		}
+		return DifferenceMinor
+	case compareCoreNumbers(a, b, 2) != 0:
+		if highIsPrerelease {
+			return DifferencePrepatch
+		}
+		return DifferencePatch
+	default:
+		return DifferencePrerelease
+	}
+}
+
+func equalIdentifiers(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,63 @@
+package semver
+
+import "testing"
+
+// most test cases taken from https://github.com/npm/node-semver/blob/main/test/functions/diff.js
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want Difference
+	}{
+		{"1.2.3", "0.2.3", DifferenceMajor},
+		{"0.2.3", "1.2.3", DifferenceMajor},
+		{"1.4.5", "0.2.3", DifferenceMajor},
+		{"1.2.3", "2.0.0-pre", DifferencePremajor},
+		{"1.2.3", "1.3.3", DifferenceMinor},
+		{"1.0.1", "1.1.0-pre", DifferencePreminor},
+		{"1.2.3", "1.2.4", DifferencePatch},
+		{"1.2.3", "1.2.4-pre", DifferencePrepatch},
+		{"0.0.1", "0.0.1-pre", DifferencePatch},
+		{"0.0.1", "0.0.1-pre-2", DifferencePatch},
+		{"1.1.0", "1.1.0-pre", DifferenceMinor},
+		{"1.1.0-pre-1", "1.1.0-pre-2", DifferencePrerelease},
+		{"1.0.0", "1.0.0", DifferenceNone},
+		{"1.0.0-1", "1.0.0-1", DifferenceNone},
+		{"0.0.2-1", "0.0.2", DifferencePatch},
+		{"0.0.2-1", "0.0.3", DifferencePatch},
+		{"0.0.2-1", "0.1.0", DifferenceMinor},
+		{"0.0.2-1", "1.0.0", DifferenceMajor},
+		{"0.1.0-1", "0.1.0", DifferenceMinor},
+		{"1.0.0-1", "1.0.0", DifferenceMajor},
+		{"1.0.0-1", "1.1.1", DifferenceMajor},
+		{"1.0.0-1", "2.1.1", DifferenceMajor},
+		{"1.0.1-1", "1.0.1", DifferencePatch},
+		{"0.0.0-1", "0.0.0", DifferenceMajor},
+		{"1.0.0-1", "2.0.0", DifferenceMajor},
+		{"1.0.0-1", "2.0.0-1", DifferencePremajor},
+		{"1.0.0-1", "1.1.0-1", DifferencePreminor},
+		{"1.0.0-1", "1.0.1-1", DifferencePrepatch},
+		{"1.2.3+1", "1.2.3+2", DifferenceBuildOnly},
+		{"1.2.3", "1.2.3+build", DifferenceBuildOnly},
+		{"1.2.3-rc.1+a", "1.2.3-rc.1+a", DifferenceNone},
+		{"1.2.3+1", "1.2.4+1", DifferencePatch},
+		{"18446744073709551616.0.0", "18446744073709551617.0.0", DifferenceMajor},
+		{"18446744073709551616.1.0", "18446744073709551616.2.0-rc.1", DifferencePreminor},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+" "+tt.b, func(t *testing.T) {
+			if got := Diff(mkv(tt.a), mkv(tt.b)); got != tt.want {
+				t.Errorf("Diff() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDifference_String(t *testing.T) {
+	for d, want := range map[Difference]string{DifferenceNone: "none", DifferencePremajor: "premajor",
+		DifferenceBuildOnly: "build-only", Difference(200): "unknown"} {
+		if got := d.String(); got != want {
+			t.Errorf("Difference(%d).String() = %v, want %v", d, got, want)
+		}
+	}
+}
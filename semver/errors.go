@@ -0,0 +1,139 @@
+package semver
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Component is a section of a version string.
+type Component uint8
+
+const (
+	// ComponentCore is the major, minor and patch version, eg `1.2.3` in `1.2.3-rc.1+build.5`.
+	ComponentCore Component = iota
+	// ComponentPrerelease is the prerelease identifiers, eg `rc.1` in `1.2.3-rc.1+build.5`.
+	ComponentPrerelease
+	// ComponentBuild is the build metadata, eg `build.5` in `1.2.3-rc.1+build.5`.
+	ComponentBuild
+)
+
+func (c Component) String() string {
+	switch c {
+	case ComponentCore:
+		return "version core"
+	case ComponentPrerelease:
+		return "pre-release"
+	case ComponentBuild:
+		return "build"
+	default:
+		return fmt.Sprintf("Component(%d)", c)
+	}
+}
+
+// ParseErrorCode describes what was wrong with a version string. Each code corresponds to one of the ErrorXxx
+// variables in this package.
+type ParseErrorCode uint8
+
+const (
+	// ParseErrorIncompleteVersionCore corresponds to ErrorIncompleteVersionCore.
+	ParseErrorIncompleteVersionCore ParseErrorCode = iota
+	// ParseErrorLeadingZero corresponds to ErrorLeadingZero.
+	ParseErrorLeadingZero
+	// ParseErrorEmptyPrereleaseIdentifier corresponds to ErrorEmptyPrereleaseIdentifier.
+	ParseErrorEmptyPrereleaseIdentifier
+	// ParseErrorEmptyBuildIdentifier corresponds to ErrorEmptyBuildIdentifier.
+	ParseErrorEmptyBuildIdentifier
+	// ParseErrorUnrecognisedCharacter corresponds to ErrorUnrecognisedCharacter.
+	ParseErrorUnrecognisedCharacter
+	// ParseErrorNegativeNumber corresponds to ErrorNegativeNumber.
+	ParseErrorNegativeNumber
+)
+
+// Err returns the error variable that corresponds to the code.
+func (c ParseErrorCode) Err() error {
+	switch c {
+	case ParseErrorIncompleteVersionCore:
+		return ErrorIncompleteVersionCore
+	case ParseErrorLeadingZero:
+		return ErrorLeadingZero
+	case ParseErrorEmptyPrereleaseIdentifier:
+		return ErrorEmptyPrereleaseIdentifier
+	case ParseErrorEmptyBuildIdentifier:
+		return ErrorEmptyBuildIdentifier
+	case ParseErrorNegativeNumber:
+		return ErrorNegativeNumber
+	default:
+		return ErrorUnrecognisedCharacter
+	}
+}
+
+// ParseError is returned when a version string cannot be parsed, or by Version.Validate when a Version could not have
+// been parsed. It describes where the problem is so that it can be pointed out to a user.
+//
+// ParseError wraps the corresponding ErrorXxx variable, so `errors.Is(err, semver.ErrorLeadingZero)` works as
+// expected.
+type ParseError struct {
+	// Input is the string that was being parsed, or the result of Version.String for Version.Validate.
+	Input string
+	// Offset is the byte offset in Input at which the problem was found. For an incomplete or empty section, this is
+	// where the missing part was expected to be.
+	Offset int
+	// Component is the section of the version that the problem was found in.
+	Component Component
+	// Code describes the problem.
+	Code ParseErrorCode
+}
+
+func newParseError(input string, offset int, component Component, code ParseErrorCode) *ParseError {
+	return &ParseError{Input: input, Offset: offset, Component: component, Code: code}
+}
+
+func (e *ParseError) Error() string {
+	msg := e.Code.Err().Error()
+	if e.Code == ParseErrorUnrecognisedCharacter && e.Offset < len(e.Input) {
+		char, _ := utf8.DecodeRuneInString(e.Input[e.Offset:])
+		msg += fmt.Sprintf(" '%c'", char)
+	}
+	return fmt.Sprintf("%s in %s at offset %d of %q", msg, e.Component, e.Offset, e.Input)
+}
+
+// Unwrap returns the ErrorXxx variable that corresponds to the error's code.
+func (e *ParseError) Unwrap() error {
+	return e.Code.Err()
+}
+
+// ParseFailure is a version string that ParseAll could not parse.
+type ParseFailure struct {
+	// Index is the position of the version string in the slice given to ParseAll.
+	Index int
+	// Input is the version string.
+	Input string
+	// Err is the error returned by Parse.
+	Err error
+}
+
+// ParseMultipleError is returned by ParseAll when any of the version strings it's given can't be parsed.
+type ParseMultipleError struct {
+	// Total is the number of version strings that ParseAll was given.
+	Total int
+	// Failures lists each version string that couldn't be parsed, in the order they were given.
+	Failures []ParseFailure
+}
+
+func (e *ParseMultipleError) Error() string {
+	msgs := make([]string, len(e.Failures))
+	for i, f := range e.Failures {
+		msgs[i] = fmt.Sprintf("%d: %v", f.Index, f.Err)
+	}
+	return fmt.Sprintf("semver: ParseAll: %d of %d versions could not be parsed: %s", len(e.Failures), e.Total, strings.Join(msgs, "; "))
+}
+
+// Unwrap returns the error for each version string that couldn't be parsed.
+func (e *ParseMultipleError) Unwrap() []error {
+	errs := make([]error, len(e.Failures))
+	for i, f := range e.Failures {
+		errs[i] = f.Err
+	}
+	return errs
+}
@@ -0,0 +1,143 @@
+package semver
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseError(t *testing.T) {
+	tests := []struct {
+		args      string
+		offset    int
+		component Component
+		want      error
+	}{
+		{args: "1.2", offset: 3, component: ComponentCore, want: ErrorIncompleteVersionCore},
+		{args: "1.2-rc.1", offset: 3, component: ComponentCore, want: ErrorIncompleteVersionCore},
+		{args: "1.2.", offset: 4, component: ComponentCore, want: ErrorIncompleteVersionCore},
+		{args: "1.02.3", offset: 2, component: ComponentCore, want: ErrorLeadingZero},
+		{args: "1.a.3", offset: 2, component: ComponentCore, want: ErrorUnrecognisedCharacter},
+		{args: "1..3", offset: 2, component: ComponentCore, want: ErrorUnrecognisedCharacter},
+		{args: "1.2.3.4", offset: 5, component: ComponentCore, want: ErrorUnrecognisedCharacter},
+		{args: "1.2.3-", offset: 6, component: ComponentPrerelease, want: ErrorEmptyPrereleaseIdentifier},
+		{args: "1.2.3-a..b", offset: 8, component: ComponentPrerelease, want: ErrorEmptyPrereleaseIdentifier},
+		{args: "1.2.3-.a", offset: 6, component: ComponentPrerelease, want: ErrorEmptyPrereleaseIdentifier},
+		{args: "1.2.3-a.+b", offset: 8, component: ComponentPrerelease, want: ErrorEmptyPrereleaseIdentifier},
+		{args: "1.2.3-rc.01", offset: 9, component: ComponentPrerelease, want: ErrorLeadingZero},
+		{args: "1.2.3-rc#1", offset: 8, component: ComponentPrerelease, want: ErrorUnrecognisedCharacter},
+		{args: "1.2.3-rc+", offset: 9, component: ComponentBuild, want: ErrorEmptyBuildIdentifier},
+		{args: "1.2.3+b.", offset: 8, component: ComponentBuild, want: ErrorEmptyBuildIdentifier},
+		{args: "1.2.3+b.01", offset: 8, component: ComponentBuild, want: ErrorLeadingZero},
+		{args: "1.2.3+ћ", offset: 6, component: ComponentBuild, want: ErrorUnrecognisedCharacter},
+		{args: "1.2.3-099999999999999999999", offset: 6, component: ComponentPrerelease, want: ErrorLeadingZero},
+	}
+	for _, tt := range tests {
+		t.Run(tt.args, func(t *testing.T) {
+			_, err := Parse(tt.args)
+
+			var pe *ParseError
+			if !errors.As(err, &pe) {
+				t.Fatalf("Parse() error = %v, want *ParseError", err)
+			}
+			if pe.Input != tt.args {
+				t.Errorf("ParseError.Input = %v, want %v", pe.Input, tt.args)
+			}
+			if pe.Offset != tt.offset {
+				t.Errorf("ParseError.Offset = %v, want %v", pe.Offset, tt.offset)
+			}
+			if pe.Component != tt.component {
+				t.Errorf("ParseError.Component = %v, want %v", pe.Component, tt.component)
+			}
+			if !errors.Is(err, tt.want) {
+				t.Errorf("errors.Is(%v, %v) = false, want true", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseError_Error(t *testing.T) {
+	tests := []struct {
+		args string
+		want string
+	}{
+		{args: "1.02.3", want: `semver: Parse: leading zeros are disallowed in version core at offset 2 of "1.02.3"`},
+		{args: "1.2.3-rc#1", want: `semver: Parse: unrecognised character '#' in pre-release at offset 8 of "1.2.3-rc#1"`},
+		{args: "1.2.3+ћ", want: `semver: Parse: unrecognised character 'ћ' in build at offset 6 of "1.2.3+ћ"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.args, func(t *testing.T) {
+			if _, err := Parse(tt.args); err == nil || err.Error() != tt.want {
+				t.Errorf("Parse() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseLoose_ParseError(t *testing.T) {
+	tests := []struct {
+		args   string
+		offset int
+		want   error
+	}{
+		{args: " v1.2.3_4", offset: 7, want: ErrorUnrecognisedCharacter},
+		{args: "v1..2", offset: 3, want: ErrorIncompleteVersionCore},
+		{args: " v1.2-rc.01", offset: 9, want: ErrorLeadingZero},
+		{args: "v1.2.3rc.01", offset: 9, want: ErrorLeadingZero},
+		{args: "1.2.3rc#1", offset: 7, want: ErrorUnrecognisedCharacter},
+	}
+	for _, tt := range tests {
+		t.Run(tt.args, func(t *testing.T) {
+			_, _, err := ParseLoose(tt.args)
+
+			var pe *ParseError
+			if !errors.As(err, &pe) {
+				t.Fatalf("ParseLoose() error = %v, want *ParseError", err)
+			}
+			if pe.Input != tt.args || pe.Offset != tt.offset {
+				t.Errorf("ParseError = %q at %v, want %q at %v", pe.Input, pe.Offset, tt.args, tt.offset)
+			}
+			if !errors.Is(err, tt.want) {
+				t.Errorf("errors.Is(%v, %v) = false, want true", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseAll(t *testing.T) {
+	got, err := ParseAll([]string{"1.0.0", "junk", "2.0.0", "1.02.0", "1.5.0-rc.1"})
+
+	// the versions that could be parsed must be kept in their original order
+	if want, _ := ParseMultiple([]string{"1.0.0", "2.0.0", "1.5.0-rc.1"}); !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseAll() = %v, want %v", got, want)
+	}
+
+	var pme *ParseMultipleError
+	if !errors.As(err, &pme) {
+		t.Fatalf("ParseAll() error = %v, want *ParseMultipleError", err)
+	}
+	if pme.Total != 5 || len(pme.Failures) != 2 {
+		t.Fatalf("ParseMultipleError = %d failures of %d, want 2 of 5", len(pme.Failures), pme.Total)
+	}
+	if f := pme.Failures[0]; f.Index != 1 || f.Input != "junk" || !errors.Is(f.Err, ErrorUnrecognisedCharacter) {
+		t.Errorf("ParseMultipleError.Failures[0] = %+v, want index 1 of junk", f)
+	}
+	if f := pme.Failures[1]; f.Index != 3 || f.Input != "1.02.0" || !errors.Is(f.Err, ErrorLeadingZero) {
+		t.Errorf("ParseMultipleError.Failures[1] = %+v, want index 3 of 1.02.0", f)
+	}
+	if !errors.Is(err, ErrorLeadingZero) {
+		t.Errorf("errors.Is(%v, ErrorLeadingZero) = false, want true", err)
+	}
+
+	want := `semver: ParseAll: 2 of 5 versions could not be parsed: 1: semver: Parse: unrecognised character 'j' in version core at offset 0 of "junk"; 3: semver: Parse: leading zeros are disallowed in version core at offset 2 of "1.02.0"`
+	if err.Error() != want {
+		t.Errorf("ParseMultipleError.Error() = %v, want %v", err, want)
+	}
+
+	t.Run("No failures", func(t *testing.T) {
+		got, err := ParseAll([]string{"1.0.0", "2.0.0"})
+		if err != nil || len(got) != 2 {
+			t.Errorf("ParseAll() = %v, %v, want 2 versions and <nil>", got, err)
+		}
+	})
+}
@@ -0,0 +1,142 @@
+package semver
+
+import "fmt"
+
+// ReasonKind describes why a version did not satisfy a comparator.
+type ReasonKind uint8
+
+const (
+	// ReasonLowerBound means that the version is below a lower bound, eg `1.0.0` and `>=2.0.0`.
+	ReasonLowerBound ReasonKind = iota
+	// ReasonUpperBound means that the version is above an upper bound, eg `3.0.0` and `<3.0.0`.
+	ReasonUpperBound
+	// ReasonNotEqual means that the version does not exactly match, eg `1.0.1` and `=1.0.0`.
+	ReasonNotEqual
+	// ReasonPrerelease means that the version is a prerelease and no comparator in the set allows prereleases of that
+	// version.
+	ReasonPrerelease
+	// ReasonExcluded means that the version was explicitly excluded, eg `1.0.1` and `!=1.0.1`.
+	ReasonExcluded
+)
+
+func (k ReasonKind) String() string {
+	switch k {
+	case ReasonLowerBound:
+		return "lower bound"
+	case ReasonUpperBound:
+		return "upper bound"
+	case ReasonNotEqual:
+		return "not equal"
+	case ReasonPrerelease:
+		return "prerelease"
+	case ReasonExcluded:
+		return "excluded"
+	default:
+		return fmt.Sprintf("ReasonKind(%d)", k)
+	}
+}
+
+// Reason explains why a version did not satisfy one of the comparator sets in a Constraint.
+type Reason struct {
+	Kind    ReasonKind
+	Version *Version
+
+	// Set is the index of the `||` separated comparator set that was not satisfied.
+	Set int
+	// Comparator is the comparison that failed, for example `<3.0.0-0`. For ReasonPrerelease, this is the whole
+	// comparator set.
+	Comparator string
+	// Origin is the part of the constraint that Comparator was parsed from, for example `^2.2.1`. For ReasonPrerelease,
+	// this is the same as Comparator.
+	Origin string
+}
+
+func (r *Reason) Error() string {
+	if r.Kind == ReasonPrerelease {
+		return fmt.Sprintf("%s is a prerelease and no comparator in `%s` allows prereleases of %s", r.Version, r.Comparator, r.Version.core())
+	}
+
+	msg := fmt.Sprintf("%s does not satisfy %s", r.Version, r.Comparator)
+
+	if r.Origin != r.Comparator {
+		switch r.Kind {
+		case ReasonLowerBound:
+			msg += fmt.Sprintf(" (the lower bound of %s)", r.Origin)
+		case ReasonUpperBound:
+			msg += fmt.Sprintf(" (the upper bound of %s)", r.Origin)
+		default:
+			msg += fmt.Sprintf(" (from %s)", r.Origin)
+		}
+	}
+
+	return msg
+}
+
+// explain returns the reason that v is not within the bounds of c, or nil if it is.
+func (c *comparator) explain(v *Version) *Reason {
+	if c.check(v) {
+		return nil
+	}
+
+	r := &Reason{Version: v, Comparator: c.String(), Origin: c.origin}
+
+	switch c.prefix {
+	case "^", "~":
+		if c.version.CompareTo(v) == 1 {
+			r.Kind = ReasonLowerBound
+			r.Comparator = ">=" + c.version.String()
+		} else {
+			r.Kind = ReasonUpperBound
+			r.Comparator = "<" + c.upperbound.String()
+		}
+	case ">", ">=":
+		r.Kind = ReasonLowerBound
+	case "<", "<=":
+		r.Kind = ReasonUpperBound
+	case "!=":
+		r.Kind = ReasonExcluded
+	default:
+		r.Kind = ReasonNotEqual
+	}
+
+	if r.Origin == "" {
+		r.Origin = c.String()
+	}
+
+	return r
+}
+
+// explain returns the reasons that v does not satisfy s.
+func (s comparatorSet) explain(v *Version, includePrerelease bool) []*Reason {
+	var reasons []*Reason
+
+	for _, c := range s {
+		if r := c.explain(v); r != nil {
+			reasons = append(reasons, r)
+		}
+	}
+
+	if len(reasons) == 0 && !s.check(v, includePrerelease) {
+		reasons = append(reasons, &Reason{Kind: ReasonPrerelease, Version: v, Comparator: s.String(), Origin: s.String()})
+	}
+
+	return reasons
+}
+
+// Validate checks v against the constraint. If v does not satisfy the constraint, the returned reasons explain why it
+// was rejected by each comparator set.
+func (c *Constraint) Validate(v *Version) (bool, []*Reason) {
+	if c.Check(v) {
+		return true, nil
+	}
+
+	var reasons []*Reason
+	for i, set := range c.sets {
+		for _, r := range set.explain(v, c.options.IncludePrerelease) {
+			r.Set = i
+			reasons = append(reasons, r)
+		}
+	}
+
+	return false, reasons
+}
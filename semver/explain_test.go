@@ -0,0 +1,85 @@
+package semver
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestConstraint_Validate(t *testing.T) {
+	type reason struct {
+		Set        int
+		Kind       ReasonKind
+		Comparator string
+		Origin     string
+	}
+
+	tests := []struct {
+		constraint string
+		version    string
+		want       []reason
+	}{
+		{constraint: "^2.2.1", version: "2.3.0"},
+		{constraint: "^2.2.1", version: "3.0.0", want: []reason{{0, ReasonUpperBound, "<3.0.0-0", "^2.2.1"}}},
+		{constraint: "^2.2.1", version: "2.2.0", want: []reason{{0, ReasonLowerBound, ">=2.2.1", "^2.2.1"}}},
+		{constraint: "~2.2.1", version: "2.3.0", want: []reason{{0, ReasonUpperBound, "<2.3.0-0", "~2.2.1"}}},
+		{constraint: "1.x", version: "2.0.0", want: []reason{{0, ReasonUpperBound, "<2.0.0-0", "1.x"}}},
+		{constraint: "1.0.0 - 2.0.0", version: "0.1.0", want: []reason{{0, ReasonLowerBound, ">=1.0.0", "1.0.0 - 2.0.0"}}},
+		{constraint: "=1.0.0", version: "1.0.1", want: []reason{{0, ReasonNotEqual, "1.0.0", "=1.0.0"}}},
+		{constraint: "^1.0.0 !=1.2.0", version: "1.2.0", want: []reason{{0, ReasonExcluded, "!=1.2.0", "!=1.2.0"}}},
+		{constraint: ">=1.0.0 <2.0.0", version: "1.5.0-beta", want: []reason{{0, ReasonPrerelease, ">=1.0.0 <2.0.0", ">=1.0.0 <2.0.0"}}},
+		{constraint: ">2.0.0 <1.0.0", version: "1.5.0", want: []reason{
+			{0, ReasonLowerBound, ">2.0.0", ">2.0.0"},
+			{0, ReasonUpperBound, "<1.0.0", "<1.0.0"},
+		}},
+		{constraint: "^1.0.0 || ^3.0.0 || 2.0.0", version: "2.1.0", want: []reason{
+			{0, ReasonUpperBound, "<2.0.0-0", "^1.0.0"},
+			{1, ReasonLowerBound, ">=3.0.0", "^3.0.0"},
+			{2, ReasonNotEqual, "2.0.0", "2.0.0"},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.constraint+" "+tt.version, func(t *testing.T) {
+			ok, reasons := MustParseConstraint(tt.constraint).Validate(mkv(tt.version))
+			if ok != (len(tt.want) == 0) {
+				t.Errorf("Constraint.Validate() = %v, want %v", ok, len(tt.want) == 0)
+			}
+
+			var got []reason
+			for _, r := range reasons {
+				if r.Version.String() != tt.version {
+					t.Errorf("Reason.Version = %v, want %v", r.Version, tt.version)
+				}
+				got = append(got, reason{r.Set, r.Kind, r.Comparator, r.Origin})
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Constraint.Validate() reasons = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReason_Error(t *testing.T) {
+	tests := []struct {
+		constraint string
+		version    string
+		want       string
+	}{
+		{constraint: "^2.2.1", version: "3.0.0", want: "3.0.0 does not satisfy <3.0.0-0 (the upper bound of ^2.2.1)"},
+		{constraint: "~2.2.1", version: "2.2.0", want: "2.2.0 does not satisfy >=2.2.1 (the lower bound of ~2.2.1)"},
+		{constraint: ">=2.0.0", version: "1.0.0", want: "1.0.0 does not satisfy >=2.0.0"},
+		{constraint: "=2.0.0", version: "1.0.0", want: "1.0.0 does not satisfy 2.0.0 (from =2.0.0)"},
+		{constraint: "^1.0.0", version: "1.1.0-rc.1", want: "1.1.0-rc.1 is a prerelease and no comparator in `^1.0.0` allows prereleases of 1.1.0"},
+		{constraint: "^1.0.0", version: "1.18446744073709551616.0-rc.1", want: "1.18446744073709551616.0-rc.1 is a prerelease and no comparator in `^1.0.0` allows prereleases of 1.18446744073709551616.0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.constraint+" "+tt.version, func(t *testing.T) {
+			_, reasons := MustParseConstraint(tt.constraint).Validate(mkv(tt.version))
+			if len(reasons) != 1 {
+				t.Fatalf("Constraint.Validate() returned %d reasons, want 1", len(reasons))
+			}
+			if got := reasons[0].Error(); got != tt.want {
+				t.Errorf("Reason.Error() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
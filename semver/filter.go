@@ -5,75 +5,203 @@ import (
 	"strings"
 )
 
-type filterFunction func(v *Version) bool
-
+// Filter removes any versions from options that do not satisfy filter. Filtering is performed in place, reusing the
+// backing array of options.
 func Filter(filter string, options Slice) (Slice, error) {
 
-	filterFunc, err := parseFilter(filter)
+	constraint, err := parseFilter(filter, ConstraintOptions{})
 	if err != nil {
 		return nil, err
 	}
 
-	var n int
-	for _, x := range options {
-		// true denotes an item to keep
-		if filterFunc(x) {
-			options[n] = x
-			n += 1
-		}
+	return constraint.Filter(options), nil
+}
+
+// FilterCopy returns the versions in options that satisfy filter, in the same order. Unlike Filter, options is not
+// modified.
+func FilterCopy(filter string, options Slice) (Slice, error) {
+
+	constraint, err := parseFilter(filter, ConstraintOptions{})
+	if err != nil {
+		return nil, err
 	}
-	options = options[:n]
 
-	return options, nil
+	return constraint.FilterCopy(options), nil
+}
+
+// FilterIndices returns the indices of the versions in options that satisfy filter, in ascending order. options is
+// not modified.
+func FilterIndices(filter string, options Slice) ([]int, error) {
+
+	constraint, err := parseFilter(filter, ConstraintOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return constraint.FilterIndices(options), nil
 }
 
 var (
-	ErrorNoFilter             = errors.New("semver: Filter: no filter provided")
-	ErrorEmptyFilter          = errors.New("semver: Filter: empty filter")
+	ErrorNoFilter    = errors.New("semver: Filter: no filter provided")
+	ErrorEmptyFilter = errors.New("semver: Filter: empty filter")
+
+	ErrorNotEqualWildcard = errors.New("semver: Filter: != filter must not contain wildcards")
+
+	// Deprecated: ErrorPrereleaseDisallowed is no longer returned. `^` filters may have prerelease identifiers, in which
+	// case prereleases of the same version are matched.
 	ErrorPrereleaseDisallowed = errors.New("semver: Filter: ^ filter must not have prerelease identifiers")
 
-	allowableFilterPrefixes = []string{"^", "~", ">=", "<=", ">", "<", "="}
+	allowableFilterPrefixes = []string{"^", "~>", "~", ">=", "<=", "!=", ">", "<", "="}
 )
 
-func parseFilter(filter string) (filterFunction, error) {
+// comparator is a single version comparison within a filter, for example `>=2.1.0` or `^1.0.0`.
+type comparator struct {
+	prefix  string
+	version *Version
 
-	if filter == "" {
-		return nil, ErrorNoFilter
-	}
+	// upperbound is the exclusive upper bound of `^` and `~` comparators
+	upperbound *Version
 
-	if strings.Index(filter, "||") != -1 {
-		// handle uses of ||
+	// origin is the part of the filter that the comparator was parsed from, for example `1.x` for `>=1.0.0`
+	origin string
+}
 
-		splitOrSegments := strings.Split(filter, "||")
-		var filterFunctions []filterFunction
-		for _, block := range splitOrSegments {
-			ff, err := parseFilter(strings.TrimSpace(block))
-			if err != nil {
-				return nil, err
-			}
-			filterFunctions = append(filterFunctions, ff)
+func newComparator(prefix string, version *Version) *comparator {
+	c := &comparator{prefix: prefix, version: version}
+
+	switch prefix {
+	case "^":
+		// match the same version and any newer versions that don't increment the first non-zero segment of the version
+		// `^2.2.1` can be expanded out as `>=2.2.1 <3.0.0-0`
+
+		var upperbound *Version
+
+		if version.Major != 0 {
+			upperbound = version.nextCore(0)
+		} else if version.Minor != 0 {
+			upperbound = version.nextCore(1)
+		} else {
+			upperbound = version.nextCore(2)
 		}
 
-		return func(v *Version) bool {
-			for _, ff := range filterFunctions {
-				if ff(v) {
-					return true
-				}
-			}
-			return false
-		}, nil
+		c.upperbound = lowestPrerelease(upperbound)
+
+	case "~":
+		// include everything greater than or equal to the stated version in the current minor range
+		// `~2.2.1` can be expanded out as `>=2.2.1 <2.3.0-0`
+		c.upperbound = lowestPrerelease(version.nextCore(1))
+	}
+
+	return c
+}
+
+// lowestPrerelease returns the lowest possible prerelease of the version core of v, for example `1.2.3-0` for `1.2.3`.
+// This is used for exclusive upper bounds so that prereleases of the bound itself are excluded.
+func lowestPrerelease(v *Version) *Version {
+	x := v.core()
+	x.Prerelease = []string{"0"}
+	return x
+}
+
+// check returns true if v is within the bounds of the comparator. This does not take into account whether v is a
+// prerelease - see comparatorSet.check.
+func (c *comparator) check(v *Version) bool {
+	switch c.prefix {
+	case "*":
+		// any version
+		return true
+	case "^", "~":
+		// c.version <= v < c.upperbound
+		return c.version.CompareTo(v) <= 0 && c.upperbound.CompareTo(v) == 1
+	case ">":
+		// v > c.version
+		return c.version.CompareTo(v) == -1
+	case "<":
+		// v < c.version
+		return c.version.CompareTo(v) == 1
+	case ">=":
+		// v >= c.version
+		return c.version.CompareTo(v) <= 0
+	case "<=":
+		// v <= c.version
+		return c.version.CompareTo(v) >= 0
+	case "=":
+		// v == c.version
+		return c.version.CompareTo(v) == 0
+	case "!=":
+		// v != c.version
+		return c.version.CompareTo(v) != 0
+	default:
+		panic("this should never happen")
+	}
+}
+
+// allowsPrereleaseOf returns true if the comparator explicitly mentions a prerelease with the same version core as v.
+// Excluding a prerelease with `!=` doesn't count.
+func (c *comparator) allowsPrereleaseOf(v *Version) bool {
+	return c.prefix != "!=" && c.version != nil && len(c.version.Prerelease) != 0 && compareVersionCore(c.version, v) == 0
+}
+
+func (c *comparator) String() string {
+	if c.prefix == "*" {
+		return "*"
+	} else if c.prefix == "=" {
+		return c.version.String()
+	}
+	return c.prefix + c.version.String()
+}
+
+func parseFilter(filter string, options ConstraintOptions) (*Constraint, error) {
+
+	constraint := &Constraint{options: options}
 
+	if strings.TrimSpace(filter) == "" {
+		// an empty filter matches any version, the same as `*`
+		constraint.sets = []comparatorSet{{{prefix: "*"}}}
+		return constraint, nil
 	}
 
-	splitFilter := strings.Split(filter, " ")
+	// handle uses of ||
+	for _, block := range options.Dialect.splitSets(filter) {
+		set, err := parseComparatorSet(strings.TrimSpace(block), options)
+		if err != nil {
+			return nil, err
+		}
+		constraint.sets = append(constraint.sets, set)
+	}
+
+	return constraint, nil
+}
+
+func parseComparatorSet(filter string, options ConstraintOptions) (comparatorSet, error) {
+
+	if filter == "" {
+		return nil, ErrorNoFilter
+	}
 
-	var filterFunctions []filterFunction
+	var set comparatorSet
 
-	for _, rawFilter := range splitFilter {
+	rawFilters := options.Dialect.splitComparators(filter)
+	for i := 0; i < len(rawFilters); i += 1 {
+		rawFilter := rawFilters[i]
 		if rawFilter == "" {
 			return nil, ErrorEmptyFilter
 		}
 
+		if i+2 < len(rawFilters) && rawFilters[i+1] == "-" {
+			// hyphen range, eg `1.2.3 - 2.3.4`
+			cs, err := parseHyphenRange(rawFilter, rawFilters[i+2], options)
+			if err != nil {
+				return nil, err
+			}
+			for _, c := range cs {
+				c.origin = strings.Join(rawFilters[i:i+3], " ")
+			}
+			set = append(set, cs...)
+			i += 2
+			continue
+		}
+
 		var prefix string
 		for _, possiblePrefix := range allowableFilterPrefixes {
 			if strings.HasPrefix(rawFilter, possiblePrefix) {
@@ -82,92 +210,234 @@ func parseFilter(filter string) (filterFunction, error) {
 			}
 		}
 
+		if rawFilter == prefix && i+1 < len(rawFilters) {
+			// whitespace between a prefix and its version, eg `>= 1.2.3`
+			if rawFilters[i+1] == "" {
+				return nil, ErrorEmptyFilter
+			}
+			i += 1
+			rawFilter += rawFilters[i]
+		}
+
 		// If the prefix is rubbish, it'll be caught here as an error and returned
-		parsedFilterVersion, err := Parse(rawFilter[len(prefix):])
+		partial, err := parsePartial(rawFilter[len(prefix):])
 		if err != nil {
 			return nil, err
 		}
 
-		// this if-block must be placed below the call to Parse since it will affect what is and isn't cut off the
-		// start of the `rawFilter` string
-		if prefix == "" {
-			// no prefix means match the version exactly
-			prefix = "="
+		// this must be placed below the call to parsePartial since it will affect what is and isn't cut off the start
+		// of the `rawFilter` string
+		prefix = options.Dialect.operator(prefix)
+
+		if options.Dialect.fillsPartials(prefix) && partial.components != 0 {
+			if partial.wildcard {
+				if prefix == "!=" {
+					return nil, ErrorNotEqualWildcard
+				}
+			} else {
+				partial.components = 3
+			}
 		}
 
-		var ffunc filterFunction
-		switch prefix {
-		case "^":
-			// match the same version and any newer versions that don't increment the first non-zero segment of the version
-			// `^2.2.1` can be expanded out as `>=2.2.1 <3.0.0`
+		cs, err := expandPartial(prefix, partial, options)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range cs {
+			c.origin = rawFilter
+		}
+		set = append(set, cs...)
+	}
 
-			if len(parsedFilterVersion.Prerelease) != 0 {
-				return nil, ErrorPrereleaseDisallowed
-			}
+	return set, nil
+}
 
-			upperbound := new(Version)
-
-			if parsedFilterVersion.Major != 0 {
-				upperbound.Major = parsedFilterVersion.Major + 1
-			} else if parsedFilterVersion.Minor != 0 {
-				upperbound.Major = parsedFilterVersion.Major
-				upperbound.Minor = parsedFilterVersion.Minor + 1
-			} else if parsedFilterVersion.Patch != 0 {
-				upperbound.Major = parsedFilterVersion.Major
-				upperbound.Minor = parsedFilterVersion.Minor
-				upperbound.Patch = parsedFilterVersion.Patch + 1
-			}
+// expandPartial converts a prefix and a possibly partial version into comparators. Partial versions are expanded out
+// into the equivalent bounded range, for example `1.2.x` can be expanded out as `>=1.2.0 <1.3.0-0` and `^1` as
+// `>=1.0.0 <2.0.0-0`.
+func expandPartial(prefix string, p *partialVersion, options ConstraintOptions) ([]*comparator, error) {
 
-			ffunc = func(v *Version) bool {
-				return parsedFilterVersion.CompareTo(v) <= 0 && upperbound.CompareTo(v) == 1 && len(v.Prerelease) == 0
-			}
+	if p.components == 3 {
+		if prefix == "~>" {
+			// with a complete version, `~>` and `~` are the same
+			prefix = "~"
+		}
+		return []*comparator{newComparator(prefix, p.version)}, nil
+	}
 
-		case "~":
-			// include everything greater than or equal to the stated version in the current minor range
-			ffunc = func(v *Version) bool {
-				if parsedFilterVersion.Minor != v.Minor || parsedFilterVersion.Major != v.Major {
-					return false
-				}
-				return parsedFilterVersion.CompareTo(v) <= 0
-			}
-		case ">":
-			ffunc = func(v *Version) bool {
-				// v > parsedFilterVersion
-				return parsedFilterVersion.CompareTo(v) == -1 && len(v.Prerelease) == 0
-			}
-		case "<":
-			ffunc = func(v *Version) bool {
-				// v < parsedFilterVersion
-				return parsedFilterVersion.CompareTo(v) == 1 && len(v.Prerelease) == 0
-			}
-		case ">=":
-			ffunc = func(v *Version) bool {
-				// v >= parsedFilterVersion
-				return parsedFilterVersion.CompareTo(v) <= 0 && len(v.Prerelease) == 0
-			}
-		case "<=":
-			ffunc = func(v *Version) bool {
-				// v <= parsedFilterVersion
-				return parsedFilterVersion.CompareTo(v) >= 0 && len(v.Prerelease) == 0
-			}
-		case "=":
-			ffunc = func(v *Version) bool {
-				// v == parsedFilterVersion
-				return parsedFilterVersion.CompareTo(v) == 0
-			}
-		default:
-			panic("this should never happen")
+	if p.components == 0 {
+		switch prefix {
+		case ">", "<":
+			// nothing is greater or less than every version
+			return []*comparator{{prefix: "<", version: lowestPrerelease(new(Version))}}, nil
+		case "!=":
+			return nil, ErrorNotEqualWildcard
 		}
+		return []*comparator{{prefix: "*"}}, nil
+	}
 
-		filterFunctions = append(filterFunctions, ffunc)
+	lowerbound := p.floor(options.IncludePrerelease)
+
+	switch prefix {
+	case ">=":
+		return []*comparator{{prefix: ">=", version: lowerbound}}, nil
+	case ">":
+		next := p.next()
+		if options.IncludePrerelease {
+			next = lowestPrerelease(next)
+		}
+		return []*comparator{{prefix: ">=", version: next}}, nil
+	case "<":
+		return []*comparator{{prefix: "<", version: lowestPrerelease(p.version)}}, nil
+	case "<=":
+		return []*comparator{{prefix: "<", version: lowestPrerelease(p.next())}}, nil
+	case "~>":
+		// the second to last component given can be incremented, eg `~>1.2` can be expanded out as `>=1.2.0 <2.0.0-0`.
+		// `~>1` is the same as `~>1.0`.
+		return []*comparator{{prefix: ">=", version: lowerbound}, {prefix: "<", version: lowestPrerelease(p.version.nextCore(0))}}, nil
+	case "^":
+		// the first non-zero component given can't be incremented, eg `^0.2` can be expanded out as
+		// `>=0.2.0 <0.3.0-0` but `^0` as `>=0.0.0 <1.0.0-0`
+		upperbound := p.version.nextCore(0)
+		if p.version.Major == 0 && p.components == 2 {
+			upperbound = p.version.nextCore(1)
+		}
+		return []*comparator{{prefix: ">=", version: lowerbound}, {prefix: "<", version: lowestPrerelease(upperbound)}}, nil
+	default:
+		// `~` and `=` both match anything within the components given
+		return []*comparator{{prefix: ">=", version: lowerbound}, {prefix: "<", version: lowestPrerelease(p.next())}}, nil
 	}
+}
 
-	return func(v *Version) bool {
-		for _, ff := range filterFunctions {
-			if !ff(v) {
-				return false
-			}
+// parseHyphenRange parses an inclusive hyphen range. Either bound may be a partial version, in which case the lower
+// bound has any missing components filled with zeros and the upper bound matches anything below the next version at
+// the level of the last component given (eg `1.2 - 2` can be expanded out as `>=1.2.0 <3.0.0-0`).
+func parseHyphenRange(rawLower, rawUpper string, options ConstraintOptions) ([]*comparator, error) {
+
+	lower, err := parsePartial(rawLower)
+	if err != nil {
+		return nil, err
+	}
+
+	upper, err := parsePartial(rawUpper)
+	if err != nil {
+		return nil, err
+	}
+
+	var cs []*comparator
+
+	switch lower.components {
+	case 0:
+		// no lower bound
+	case 3:
+		cs = append(cs, &comparator{prefix: ">=", version: lower.version})
+	default:
+		cs = append(cs, &comparator{prefix: ">=", version: lower.floor(options.IncludePrerelease)})
+	}
+
+	switch upper.components {
+	case 0:
+		// no upper bound
+	case 3:
+		cs = append(cs, &comparator{prefix: "<=", version: upper.version})
+	default:
+		cs = append(cs, &comparator{prefix: "<", version: lowestPrerelease(upper.next())})
+	}
+
+	if len(cs) == 0 {
+		cs = append(cs, &comparator{prefix: "*"})
+	}
+
+	return cs, nil
+}
+
+// partialVersion is a version that may be missing components or use `x`, `X` or `*` in place of them, for example
+// `1.2`, `2.x` or `*`.
+type partialVersion struct {
+	// version has any missing components set to zero
+	version *Version
+	// components is the number of version core components that were specified
+	components int
+	// wildcard is set if the version was empty or any components were given as `x`, `X` or `*`
+	wildcard bool
+}
+
+func isWildcard(x string) bool {
+	return x == "x" || x == "X" || x == "*"
+}
+
+func parsePartial(in string) (*partialVersion, error) {
+
+	if in == "" {
+		return &partialVersion{version: new(Version), wildcard: true}, nil
+	}
+
+	if strings.ContainsAny(in, "-+") {
+		// partial versions cannot have prerelease or build identifiers, so leave Parse to reject anything incomplete.
+		// This is checked first as `x` is a valid prerelease or build identifier, eg `1.2.3-alpha.x`
+		return parseFullPartial(in)
+	}
+
+	parts := strings.Split(in, ".")
+
+	components := len(parts)
+	for i, part := range parts {
+		if isWildcard(part) {
+			components = i
+			break
 		}
-		return true
-	}, nil
+	}
+
+	for _, part := range parts[components:] {
+		if !isWildcard(part) {
+			// numbers after a wildcard, eg `1.x.3`, or something with a wildcard that isn't a partial version at all
+			return parseFullPartial(in)
+		}
+	}
+
+	if components >= 3 {
+		return parseFullPartial(in)
+	}
+
+	wildcard := components != len(parts)
+
+	if components == 0 {
+		return &partialVersion{version: new(Version), wildcard: wildcard}, nil
+	}
+
+	v, err := Parse(strings.Join(parts[:components], ".") + strings.Repeat(".0", 3-components))
+	if err != nil {
+		return nil, err
+	}
+
+	return &partialVersion{version: v, components: components, wildcard: wildcard}, nil
+}
+
+func parseFullPartial(in string) (*partialVersion, error) {
+	v, err := Parse(in)
+	if err != nil {
+		return nil, err
+	}
+	return &partialVersion{version: v, components: 3}, nil
+}
+
+// floor returns the lowest version matching p. If includePrerelease is set, this is the lowest prerelease of that
+// version so that prereleases at the bottom of the range are matched too.
+func (p *partialVersion) floor(includePrerelease bool) *Version {
+	if includePrerelease {
+		return lowestPrerelease(p.version)
+	}
+	return p.version
+}
+
+// next returns the lowest release that is greater than every version matching p, for example `1.3.0` for `1.2`.
+func (p *partialVersion) next() *Version {
+	switch p.components {
+	case 1:
+		return p.version.nextCore(0)
+	case 2:
+		return p.version.nextCore(1)
+	default:
+		return p.version.nextCore(2)
+	}
 }
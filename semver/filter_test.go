@@ -26,7 +26,8 @@ func (tests filterTests) Run(t *testing.T) {
 				t.Errorf("Filter() error = %v, wantErr %v (got %v)", err, tt.wantErr, got)
 				return
 			}
-			if !reflect.DeepEqual(got, tt.want) && len(got) != 0 && len(tt.want) != 0 {
+			// an empty result can be nil or an empty slice
+			if len(got) != len(tt.want) || (len(got) != 0 && !reflect.DeepEqual(got, tt.want)) {
 				t.Errorf("Filter() = %v, want %v", got, tt.want)
 			}
 		})
@@ -79,15 +80,147 @@ func TestFilterByNormal(t *testing.T) {
 	}.Run(t)
 }
 
+func TestFilterByHyphenRange(t *testing.T) {
+	filterTests{
+		{args: ft("2.2.1 - 2.4.0"), want: mustParseMultiple("2.2.1", "2.3.0", "2.4.0")},
+		{args: ft("0.10.0 - 1.0.1"), want: mustParseMultiple("0.10.0", "1.0.0", "1.0.1")},
+		{args: ft("3.9 - 3"), want: mustParseMultiple("3.9.0", "3.9.1", "3.9.2", "3.9.3", "3.10.0", "3.10.1")},
+		{args: ft("1.3 - 2.0"), want: mustParseMultiple("1.3.0", "1.3.1", "2.0.0")},
+		{args: ft("1 - 1"), want: mustParseMultiple("1.0.0", "1.0.1", "1.0.2", "1.1.0", "1.1.1", "1.2.0", "1.2.1", "1.3.0", "1.3.1")},
+
+		// hyphen ranges combine with other comparators
+		{args: ft("2.2.1 - 2.4.2 <2.4.0"), want: mustParseMultiple("2.2.1", "2.3.0")},
+		{args: ft("0.0.1 || 2.2.1 - 2.3.0"), want: mustParseMultiple("0.0.1", "2.2.1", "2.3.0")},
+	}.Run(t)
+
+	filterTests{
+		{name: "Missing upper bound", args: ft("1.0.0 -"), wantErr: true},
+		{name: "Missing lower bound", args: ft("- 1.0.0"), wantErr: true},
+		{name: "Prefixed bound", args: ft(">1.0.0 - 2.0.0"), wantErr: true},
+		{name: "Partial with prerelease", args: ft("1.0.0 - 2-rc.1"), wantErr: true},
+	}.Run(t)
+}
+
+func TestFilterByXRange(t *testing.T) {
+	// wildcards don't match prereleases
+	var all Slice
+	for _, v := range ft("").options {
+		if len(v.Prerelease) == 0 {
+			all = append(all, v)
+		}
+	}
+
+	filterTests{
+		{args: ft("2.2.x"), want: mustParseMultiple("2.2.0", "2.2.1")},
+		{args: ft("2.2.*"), want: mustParseMultiple("2.2.0", "2.2.1")},
+		{args: ft("2.2"), want: mustParseMultiple("2.2.0", "2.2.1")},
+		{args: ft("=2.2"), want: mustParseMultiple("2.2.0", "2.2.1")},
+		{args: ft("2.X"), want: mustParseMultiple("2.0.0", "2.1.0", "2.2.0", "2.2.1", "2.3.0", "2.4.0", "2.4.1", "2.4.2")},
+		{args: ft("2"), want: mustParseMultiple("2.0.0", "2.1.0", "2.2.0", "2.2.1", "2.3.0", "2.4.0", "2.4.1", "2.4.2")},
+		{args: ft("2.x.x"), want: mustParseMultiple("2.0.0", "2.1.0", "2.2.0", "2.2.1", "2.3.0", "2.4.0", "2.4.1", "2.4.2")},
+		{args: ft("*"), want: all},
+		{args: ft("x"), want: all},
+		{args: ft(""), want: all},
+		{args: ft("   "), want: all},
+
+		{args: ft(">=4.17"), want: mustParseMultiple("4.17.0", "4.17.1", "4.17.2", "4.17.3", "4.17.4", "4.17.5", "4.17.9", "4.17.10", "4.17.11", "4.17.12", "4.17.13", "4.17.14", "4.17.15", "4.17.16", "4.17.17", "4.17.18", "4.17.19", "4.17.20", "4.17.21")},
+		{args: ft(">4.16"), want: mustParseMultiple("4.17.0", "4.17.1", "4.17.2", "4.17.3", "4.17.4", "4.17.5", "4.17.9", "4.17.10", "4.17.11", "4.17.12", "4.17.13", "4.17.14", "4.17.15", "4.17.16", "4.17.17", "4.17.18", "4.17.19", "4.17.20", "4.17.21")},
+		{args: ft("<0.2"), want: mustParseMultiple("0.0.1", "0.0.2", "0.0.3", "0.1.0")},
+		{args: ft("<=0.1"), want: mustParseMultiple("0.0.1", "0.0.2", "0.0.3", "0.1.0")},
+		{args: ft(">*"), want: Slice{}},
+		{args: ft("<*"), want: Slice{}},
+		{args: ft(">=*"), want: all},
+
+		{args: ft("^1"), want: mustParseMultiple("1.0.0", "1.0.1", "1.0.2", "1.1.0", "1.1.1", "1.2.0", "1.2.1", "1.3.0", "1.3.1")},
+		{args: ft("^1.2"), want: mustParseMultiple("1.2.0", "1.2.1", "1.3.0", "1.3.1")},
+		{args: ft("^0.2"), want: mustParseMultiple("0.2.0", "0.2.1", "0.2.2")},
+		{args: ft("^0.0"), want: mustParseMultiple("0.0.1", "0.0.2", "0.0.3")},
+		{args: ft("^0"), want: mustParseMultiple("0.0.1", "0.0.2", "0.0.3", "0.1.0", "0.2.0", "0.2.1", "0.2.2", "0.3.0", "0.3.1", "0.3.2", "0.4.0", "0.4.1", "0.4.2", "0.5.0", "0.5.1", "0.5.2", "0.6.0", "0.6.1", "0.7.0", "0.8.0", "0.8.1", "0.8.2", "0.9.0", "0.9.1", "0.9.2", "0.10.0")},
+		{args: ft("~1"), want: mustParseMultiple("1.0.0", "1.0.1", "1.0.2", "1.1.0", "1.1.1", "1.2.0", "1.2.1", "1.3.0", "1.3.1")},
+		{args: ft("~1.1"), want: mustParseMultiple("1.1.0", "1.1.1")},
+
+		{args: ft("1.x - 2.0"), want: mustParseMultiple("1.0.0", "1.0.1", "1.0.2", "1.1.0", "1.1.1", "1.2.0", "1.2.1", "1.3.0", "1.3.1", "2.0.0")},
+		{args: ft("* - 0.0.2"), want: mustParseMultiple("0.0.1", "0.0.2")},
+	}.Run(t)
+
+	filterTests{
+		{name: "Numbers after wildcard", args: ft("1.x.3"), wantErr: true},
+		{name: "Partial with prerelease", args: ft("1.2-rc.1"), wantErr: true},
+		{name: "Wildcard with prerelease", args: ft("1.x-rc.1"), wantErr: true},
+		{name: "Too many components", args: ft("1.2.3.4"), wantErr: true},
+	}.Run(t)
+
+	// `x` is only a wildcard in the version core
+	xft := func(filter string) filterTestArgs {
+		return filterTestArgs{filter: filter, options: mustParseMultiple("1.2.3-alpha.x.0", "1.2.3-alpha.x.1", "1.2.3", "1.2.3+b.x.1")}
+	}
+	filterTests{
+		{name: "Prerelease x", args: xft("1.2.3-alpha.x.1"), want: mustParseMultiple("1.2.3-alpha.x.1")},
+		{name: "Build x", args: xft("1.2.3+b.x.1"), want: mustParseMultiple("1.2.3", "1.2.3+b.x.1")},
+		{name: "Prefixed prerelease x", args: xft(">1.2.3-alpha.x.0"), want: mustParseMultiple("1.2.3-alpha.x.1", "1.2.3", "1.2.3+b.x.1")},
+	}.Run(t)
+}
+
+func TestFilterByPrerelease(t *testing.T) {
+	filterTests{
+		// prereleases are only matched by a comparator with a prerelease of the same version core
+		{args: ft(">=1.0.0-rc.2"), want: mustParseMultiple("1.0.0-rc.2", "1.0.0-rc.3", "1.0.0", "1.0.1", "1.1.0", "1.1.1", "1.2.0", "1.2.1", "1.3.0", "1.3.1", "2.0.0", "2.1.0", "2.2.0", "2.2.1", "2.3.0", "2.4.0", "2.4.1", "3.0.0", "3.0.1", "3.1.0", "3.2.0", "3.3.0", "3.3.1", "3.4.0", "3.5.0", "3.6.0", "1.0.2", "3.7.0", "2.4.2", "3.8.0", "3.9.0", "3.9.1", "3.9.2", "3.9.3", "3.10.0", "3.10.1", "4.0.0", "4.0.1", "4.1.0", "4.2.0", "4.2.1", "4.3.0", "4.4.0", "4.5.0", "4.5.1", "4.6.0", "4.6.1", "4.7.0", "4.8.0", "4.8.1", "4.8.2", "4.9.0", "4.10.0", "4.11.0", "4.11.1", "4.11.2", "4.12.0", "4.13.0", "4.13.1", "4.14.0", "4.14.1", "4.14.2", "4.15.0", "4.16.0", "4.16.1", "4.16.2", "4.16.3", "4.16.4", "4.16.5", "4.16.6", "4.17.0", "4.17.1", "4.17.2", "4.17.3", "4.17.4", "4.17.5", "4.17.9", "4.17.10", "4.17.11", "4.17.12", "4.17.13", "4.17.14", "4.17.15", "4.17.16", "4.17.17", "4.17.18", "4.17.19", "4.17.20", "4.17.21")},
+		{args: ft(">0.5.0-rc.1 <1.0.0-rc.3"), want: mustParseMultiple("0.5.0", "0.5.1", "0.5.2", "0.6.0", "0.6.1", "0.7.0", "0.8.0", "0.8.1", "0.8.2", "0.9.0", "0.9.1", "0.9.2", "0.10.0", "1.0.0-rc.1", "1.0.0-rc.2")},
+		{args: ft("<=1.0.0-rc.2 >0.10.0"), want: mustParseMultiple("1.0.0-rc.1", "1.0.0-rc.2")},
+		{args: ft("~0.5.0-rc.1"), want: mustParseMultiple("0.5.0-rc.1", "0.5.0", "0.5.1", "0.5.2")},
+		{args: ft("~0.4.0"), want: mustParseMultiple("0.4.0", "0.4.1", "0.4.2")},
+		{args: ft("^1.0.0-rc.2"), want: mustParseMultiple("1.0.0-rc.2", "1.0.0-rc.3", "1.0.0", "1.0.1", "1.0.2", "1.1.0", "1.1.1", "1.2.0", "1.2.1", "1.3.0", "1.3.1")},
+		{args: ft("^0.10.0"), want: mustParseMultiple("0.10.0")},
+		{args: ft("0.9.x || 1.0.0-rc.1 - 1.0.0"), want: mustParseMultiple("0.9.0", "0.9.1", "0.9.2", "1.0.0-rc.1", "1.0.0-rc.2", "1.0.0-rc.3", "1.0.0")},
+
+		// the prerelease must be in the same comparator set
+		{args: ft(">=1.0.0-rc.1 <1.0.0 || >=0.5.0 <1.0.0"), want: mustParseMultiple("1.0.0-rc.1", "1.0.0-rc.2", "1.0.0-rc.3", "0.5.0", "0.5.1", "0.5.2", "0.6.0", "0.6.1", "0.7.0", "0.8.0", "0.8.1", "0.8.2", "0.9.0", "0.9.1", "0.9.2", "0.10.0")},
+	}.Run(t)
+}
+
 func TestFilterByAbnormal(t *testing.T) {
 	filterTests{
 		{name: "Invalid prefix", args: ft("z1.0.0"), wantErr: true},
-		{name: "Empty filter", args: ft(""), wantErr: true},
 		{name: "Double space", args: ft("0.0.0  0.0.0"), wantErr: true},
-		{name: "Prerelease and caret", args: ft("^1.0.0-abcdefg"), wantErr: true},
 	}.Run(t)
 }
 
+func TestFilterCopy(t *testing.T) {
+	options := ft("").options
+	original := append(Slice(nil), options...)
+
+	got, err := FilterCopy("~2.2.0", options)
+	if err != nil {
+		t.Fatalf("FilterCopy() error = %v", err)
+	}
+	if want := mustParseMultiple("2.2.0", "2.2.1"); !reflect.DeepEqual(got, want) {
+		t.Errorf("FilterCopy() = %v, want %v", got, want)
+	}
+	if !reflect.DeepEqual(options, original) {
+		t.Errorf("FilterCopy() modified options")
+	}
+
+	if _, err := FilterCopy("z1.0.0", options); err == nil {
+		t.Errorf("FilterCopy() error = <nil>, want an error")
+	}
+}
+
+func TestFilterIndices(t *testing.T) {
+	options := mustParseMultiple("0.1.0", "2.2.0", "2.2.1", "2.3.0")
+
+	got, err := FilterIndices("~2.2.0", options)
+	if err != nil {
+		t.Fatalf("FilterIndices() error = %v", err)
+	}
+	if want := []int{1, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("FilterIndices() = %v, want %v", got, want)
+	}
+
+	if _, err := FilterIndices("z1.0.0", options); err == nil {
+		t.Errorf("FilterIndices() error = <nil>, want an error")
+	}
+}
+
 //func Test_allowUnstable(t *testing.T) {
 //	tests := []struct {
 //		name string
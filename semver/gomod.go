@@ -0,0 +1,168 @@
+package semver
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+var (
+	ErrorMissingGoPrefix  = errors.New("semver: ParseGo: Go module versions must start with v")
+	ErrorNotPseudoVersion = errors.New("semver: not a pseudo-version")
+)
+
+// pseudoVersionTimeFormat is the layout of the timestamp in a pseudo-version.
+const pseudoVersionTimeFormat = "20060102150405"
+
+// ParseGo parses a version in the form used by Go modules, which is a semantic version with a leading `v`, eg
+// `v1.2.3`. Like the go command, it also accepts the shorthands `vX` and `vX.Y`, which mean `vX.0.0` and `vX.Y.0`.
+func ParseGo(in string) (*Version, error) {
+	if !strings.HasPrefix(in, "v") {
+		return nil, ErrorMissingGoPrefix
+	}
+	x := in[1:]
+
+	// shorthands can't have a prerelease or build metadata
+	if n := strings.Count(x, "."); n < 2 && strings.IndexAny(x, "-+") == -1 {
+		x += strings.Repeat(".0", 2-n)
+	}
+
+	v, err := Parse(x)
+	if err != nil {
+		if pe, ok := err.(*ParseError); ok {
+			// the error must refer to in, which may be shorter than x if it was a shorthand
+			offset := pe.Offset + 1
+			if offset > len(in) {
+				offset = len(in)
+			}
+			return nil, newParseError(in, offset, pe.Component, pe.Code)
+		}
+		return nil, err
+	}
+	return v, nil
+}
+
+// MustParseGo is the same as ParseGo, but panics if the version can't be parsed.
+func MustParseGo(in string) *Version {
+	v, err := ParseGo(in)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// FormatGo returns v in the form used by Go modules, eg `v1.2.3`.
+func FormatGo(v *Version) string {
+	return "v" + v.String()
+}
+
+// CompareGo compares two Go module version strings in the same way as golang.org/x/mod/semver's Compare: an invalid
+// version is less than any valid one, and equal to any other invalid version. 1 is a > b, -1 is a < b, 0 is a == b
+func CompareGo(a, b string) int {
+	va, erra := ParseGo(a)
+	vb, errb := ParseGo(b)
+
+	switch {
+	case erra != nil && errb != nil:
+		return 0
+	case erra != nil:
+		return -1
+	case errb != nil:
+		return 1
+	default:
+		return va.CompareTo(vb)
+	}
+}
+
+// IsIncompatible returns true if v has the `+incompatible` build metadata, which the go command uses for versions of
+// modules that have a major version of 2 or more but don't have a go.mod file.
+func (v *Version) IsIncompatible() bool {
+	return len(v.Build) == 1 && v.Build[0] == "incompatible"
+}
+
+// IsPseudoVersion returns true if v is a pseudo-version, which the go command uses to refer to a specific commit that
+// hasn't been tagged. There are three forms:
+//
+//   - vX.0.0-yyyymmddhhmmss-abcdefabcdef, when there is no earlier tagged version
+//   - vX.Y.(Z+1)-0.yyyymmddhhmmss-abcdefabcdef, when the most recent earlier tag is vX.Y.Z
+//   - vX.Y.Z-pre.0.yyyymmddhhmmss-abcdefabcdef, when the most recent earlier tag is vX.Y.Z-pre
+func (v *Version) IsPseudoVersion() bool {
+	n := len(v.Prerelease)
+	if n == 0 || !isPseudoVersionSuffix(v.Prerelease[n-1]) {
+		return false
+	}
+
+	if n == 1 {
+		return v.Minor == 0 && v.Patch == 0
+	}
+	return v.Prerelease[n-2] == "0"
+}
+
+// isPseudoVersionSuffix returns true if id is a timestamp and revision, eg `20191109021931-daa7c04131f5`.
+func isPseudoVersionSuffix(id string) bool {
+	i := strings.IndexByte(id, '-')
+	if i != len(pseudoVersionTimeFormat) || i == len(id)-1 || !isStringNumeric(id[:i]) {
+		return false
+	}
+	for _, char := range id[i+1:] {
+		if !(isDigit(char) || isLetter(char)) {
+			return false
+		}
+	}
+	return true
+}
+
+// PseudoVersionTime returns the commit time recorded in a pseudo-version. If v isn't a pseudo-version,
+// ErrorNotPseudoVersion is returned.
+func (v *Version) PseudoVersionTime() (time.Time, error) {
+	if !v.IsPseudoVersion() {
+		return time.Time{}, ErrorNotPseudoVersion
+	}
+	id := v.Prerelease[len(v.Prerelease)-1]
+	return time.Parse(pseudoVersionTimeFormat, id[:len(pseudoVersionTimeFormat)])
+}
+
+// PseudoVersionRev returns the revision identifier (usually an abbreviated commit hash) recorded in a pseudo-version.
+// If v isn't a pseudo-version, ErrorNotPseudoVersion is returned.
+func (v *Version) PseudoVersionRev() (string, error) {
+	if !v.IsPseudoVersion() {
+		return "", ErrorNotPseudoVersion
+	}
+	id := v.Prerelease[len(v.Prerelease)-1]
+	return id[len(pseudoVersionTimeFormat)+1:], nil
+}
+
+// SplitPathVersion splits a module path into the path prefix and its major version suffix, eg `example.com/mod/v2` is
+// split into `example.com/mod` and `/v2`. If the path has no suffix, pathMajor is empty. ok is false if the path ends
+// in something that looks like a suffix but isn't valid, such as `/v1` or `/v02`.
+func SplitPathVersion(path string) (prefix, pathMajor string, ok bool) {
+	i := strings.LastIndex(path, "/v")
+	if i == -1 || !isStringNumeric(path[i+2:]) {
+		return path, "", true
+	}
+
+	major := path[i+2:]
+	if major[0] == '0' || major == "1" {
+		return path, "", false
+	}
+	return path[:i], path[i:], true
+}
+
+// PathMajor returns the major version suffix that a module path must have for a module at version v, eg `/v2` for
+// `v2.1.0`. Versions with a major version of 0 or 1, and versions marked `+incompatible`, don't have a suffix.
+func (v *Version) PathMajor() string {
+	if v.Major < 2 || v.IsIncompatible() {
+		return ""
+	}
+	return "/v" + v.coreNumber(0)
+}
+
+// CheckPathMajor returns true if v may be used as a version of a module whose path has the major version suffix
+// pathMajor, as returned by SplitPathVersion.
+func (v *Version) CheckPathMajor(pathMajor string) bool {
+	if v.IsIncompatible() {
+		// +incompatible is only allowed for modules without a suffix at major version 2 or above
+		return pathMajor == "" && v.Major >= 2
+	}
+	return v.PathMajor() == pathMajor
+}
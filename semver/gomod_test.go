@@ -0,0 +1,177 @@
+package semver
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseGo(t *testing.T) {
+	tests := []struct {
+		args    string
+		want    string
+		wantErr bool
+	}{
+		{args: "v1.2.3", want: "1.2.3"},
+		{args: "v1.2", want: "1.2.0"},
+		{args: "v1", want: "1.0.0"},
+		{args: "v0.0.0-20191109021931-daa7c04131f5", want: "0.0.0-20191109021931-daa7c04131f5"},
+		{args: "v1.2.4-0.20191109021931-daa7c04131f5", want: "1.2.4-0.20191109021931-daa7c04131f5"},
+		{args: "v2.0.0+incompatible", want: "2.0.0+incompatible"},
+		{args: "1.2.3", wantErr: true},
+		{args: "v", wantErr: true},
+		{args: "v1.2-pre", wantErr: true},
+		{args: "v1.2.3.4", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.args, func(t *testing.T) {
+			got, err := ParseGo(tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseGo() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && got.String() != tt.want {
+				t.Errorf("ParseGo() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// errors refer to the input, even if it was a shorthand that was padded
+	for in, offset := range map[string]int{"v1.02.3": 3, "v1.x": 3, "v01": 1, "v1.": 3} {
+		t.Run("ParseError "+in, func(t *testing.T) {
+			_, err := ParseGo(in)
+			if pe, ok := err.(*ParseError); !ok || pe.Input != in || pe.Offset != offset {
+				t.Errorf("ParseGo() error = %v, want ParseError at offset %d of %q", err, offset, in)
+			}
+		})
+	}
+}
+
+func TestFormatGo(t *testing.T) {
+	if got := FormatGo(mkv("1.2.3+incompatible")); got != "v1.2.3+incompatible" {
+		t.Errorf("FormatGo() = %v, want v1.2.3+incompatible", got)
+	}
+}
+
+func TestCompareGo(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{a: "v1.2.3", b: "v1.2.3", want: 0},
+		{a: "v1.2", b: "v1.2.0", want: 0},
+		{a: "v1.2.3+incompatible", b: "v1.2.3", want: 0},
+		{a: "v1.2.4", b: "v1.2.3", want: 1},
+		{a: "v1.2.4-0.20191109021931-daa7c04131f5", b: "v1.2.3", want: 1},
+		{a: "v1.2.4-0.20191109021931-daa7c04131f5", b: "v1.2.4", want: -1},
+		{a: "v1.2.4-0.20191109021931-daa7c04131f5", b: "v1.2.4-0.20200101000000-aaaaaaaaaaaa", want: -1},
+		{a: "v0.0.0-20191109021931-daa7c04131f5", b: "v0.0.1", want: -1},
+		{a: "bad", b: "v0.0.0", want: -1},
+		{a: "v0.0.0", b: "1.0.0", want: 1},
+		{a: "bad", b: "worse", want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+" "+tt.b, func(t *testing.T) {
+			if got := CompareGo(tt.a, tt.b); got != tt.want {
+				t.Errorf("CompareGo() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVersion_IsPseudoVersion(t *testing.T) {
+	tests := []struct {
+		args string
+		want bool
+	}{
+		{args: "v0.0.0-20191109021931-daa7c04131f5", want: true},
+		{args: "v2.0.0-20191109021931-daa7c04131f5", want: true},
+		{args: "v1.2.4-0.20191109021931-daa7c04131f5", want: true},
+		{args: "v1.2.3-pre.0.20191109021931-daa7c04131f5", want: true},
+		{args: "v1.2.3-pre.0.20191109021931-daa7c04131f5+incompatible", want: true},
+		{args: "v1.2.0-20191109021931-daa7c04131f5", want: false},
+		{args: "v1.2.4-1.20191109021931-daa7c04131f5", want: false},
+		{args: "v1.2.4-0.2019110902193-daa7c04131f5", want: false},
+		{args: "v1.2.4-0.20191109021931-", want: false},
+		{args: "v1.2.4-0.20191109021931", want: false},
+		{args: "v1.2.3", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.args, func(t *testing.T) {
+			if got := MustParseGo(tt.args).IsPseudoVersion(); got != tt.want {
+				t.Errorf("Version.IsPseudoVersion() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVersion_PseudoVersionTimeRev(t *testing.T) {
+	v := MustParseGo("v1.2.4-0.20191109021931-daa7c04131f5")
+
+	gotTime, err := v.PseudoVersionTime()
+	if want := time.Date(2019, 11, 9, 2, 19, 31, 0, time.UTC); err != nil || !gotTime.Equal(want) {
+		t.Errorf("Version.PseudoVersionTime() = %v, %v, want %v", gotTime, err, want)
+	}
+
+	gotRev, err := v.PseudoVersionRev()
+	if err != nil || gotRev != "daa7c04131f5" {
+		t.Errorf("Version.PseudoVersionRev() = %v, %v, want daa7c04131f5", gotRev, err)
+	}
+
+	if _, err := MustParseGo("v1.2.3").PseudoVersionTime(); err != ErrorNotPseudoVersion {
+		t.Errorf("Version.PseudoVersionTime() error = %v, want ErrorNotPseudoVersion", err)
+	}
+	if _, err := MustParseGo("v1.2.3").PseudoVersionRev(); err != ErrorNotPseudoVersion {
+		t.Errorf("Version.PseudoVersionRev() error = %v, want ErrorNotPseudoVersion", err)
+	}
+}
+
+func TestSplitPathVersion(t *testing.T) {
+	tests := []struct {
+		path      string
+		prefix    string
+		pathMajor string
+		ok        bool
+	}{
+		{path: "example.com/mod", prefix: "example.com/mod", ok: true},
+		{path: "example.com/mod/v2", prefix: "example.com/mod", pathMajor: "/v2", ok: true},
+		{path: "example.com/mod/v10", prefix: "example.com/mod", pathMajor: "/v10", ok: true},
+		{path: "example.com/mod/vendor", prefix: "example.com/mod/vendor", ok: true},
+		{path: "example.com/mod/v1", prefix: "example.com/mod/v1", ok: false},
+		{path: "example.com/mod/v02", prefix: "example.com/mod/v02", ok: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			prefix, pathMajor, ok := SplitPathVersion(tt.path)
+			if prefix != tt.prefix || pathMajor != tt.pathMajor || ok != tt.ok {
+				t.Errorf("SplitPathVersion() = %v, %v, %v, want %v, %v, %v", prefix, pathMajor, ok, tt.prefix, tt.pathMajor, tt.ok)
+			}
+		})
+	}
+}
+
+func TestVersion_CheckPathMajor(t *testing.T) {
+	tests := []struct {
+		version   string
+		pathMajor string
+		want      bool
+	}{
+		{version: "v0.1.0", pathMajor: "", want: true},
+		{version: "v1.2.3", pathMajor: "", want: true},
+		{version: "v2.0.0", pathMajor: "/v2", want: true},
+		{version: "v2.0.0", pathMajor: "", want: false},
+		{version: "v3.0.0", pathMajor: "/v2", want: false},
+		{version: "v1.0.0", pathMajor: "/v2", want: false},
+		{version: "v2.0.0+incompatible", pathMajor: "", want: true},
+		{version: "v2.0.0+incompatible", pathMajor: "/v2", want: false},
+		{version: "v1.0.0+incompatible", pathMajor: "", want: false},
+		{version: "v18446744073709551616.0.0", pathMajor: "/v18446744073709551616", want: true},
+		{version: "v18446744073709551616.0.0", pathMajor: "/v9223372036854775807", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.version+" "+tt.pathMajor, func(t *testing.T) {
+			if got := MustParseGo(tt.version).CheckPathMajor(tt.pathMajor); got != tt.want {
+				t.Errorf("Version.CheckPathMajor() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package semver
+
+import "errors"
+
+var ErrorInvalidPrereleaseIdentifier = errors.New("semver: Inc: invalid prerelease identifier")
+
+// IncMajor returns the next major version after v, with no prerelease or build metadata, eg `2.0.0` for `1.2.3`. As
+// in node-semver, a prerelease of a major version is incremented to that major version, so `2.0.0-rc.1` becomes
+// `2.0.0`.
+func (v *Version) IncMajor() *Version {
+	if v.Minor != 0 || v.Patch != 0 || len(v.Prerelease) == 0 {
+		return v.nextCore(0)
+	}
+	return v.core()
+}
+
+// IncMinor returns the next minor version after v, with no prerelease or build metadata, eg `1.3.0` for `1.2.3`. A
+// prerelease of a minor version is incremented to that minor version, so `1.3.0-rc.1` becomes `1.3.0`.
+func (v *Version) IncMinor() *Version {
+	if v.Patch != 0 || len(v.Prerelease) == 0 {
+		return v.nextCore(1)
+	}
+	return v.core()
+}
+
+// IncPatch returns the next patch version after v, with no prerelease or build metadata, eg `1.2.4` for `1.2.3`. A
+// prerelease is incremented to the version it's a prerelease of, so `1.2.4-rc.1` becomes `1.2.4`.
+func (v *Version) IncPatch() *Version {
+	if len(v.Prerelease) == 0 {
+		return v.nextCore(2)
+	}
+	return v.core()
+}
+
+// IncPremajor returns the first prerelease of the next major version after v, eg `2.0.0-0` for `1.2.3`, or
+// `2.0.0-rc.0` if identifier is `rc`. identifier can be empty, but otherwise must be a single valid prerelease
+// identifier.
+func (v *Version) IncPremajor(identifier string) (*Version, error) {
+	return incPrerelease(v.nextCore(0), identifier)
+}
+
+// IncPreminor returns the first prerelease of the next minor version after v, eg `1.3.0-0` for `1.2.3`, or
+// `1.3.0-rc.0` if identifier is `rc`. identifier can be empty, but otherwise must be a single valid prerelease
+// identifier.
+func (v *Version) IncPreminor(identifier string) (*Version, error) {
+	return incPrerelease(v.nextCore(1), identifier)
+}
+
+// IncPrepatch returns the first prerelease of the next patch version after v, eg `1.2.4-0` for `1.2.3`, or
+// `1.2.4-rc.0` if identifier is `rc`. identifier can be empty, but otherwise must be a single valid prerelease
+// identifier.
+func (v *Version) IncPrepatch(identifier string) (*Version, error) {
+	return incPrerelease(v.nextCore(2), identifier)
+}
+
+// IncPrerelease returns the next prerelease after v, in the same way as node-semver's `inc` with `prerelease`.
+//
+// If v isn't a prerelease, this is the same as IncPrepatch, so `1.2.3` becomes `1.2.4-rc.0` with the identifier
+// `rc`. Otherwise, the last numeric identifier in the prerelease is incremented, or `0` is appended if there isn't
+// one, so `1.2.3-alpha.4` becomes `1.2.3-alpha.5` and `1.2.3-alpha` becomes `1.2.3-alpha.0`. If identifier is given
+// and the prerelease doesn't already start with it, the prerelease is replaced, so `1.2.3-alpha.4` becomes
+// `1.2.3-beta.0` with the identifier `beta`.
+func (v *Version) IncPrerelease(identifier string) (*Version, error) {
+	if len(v.Prerelease) == 0 {
+		return v.IncPrepatch(identifier)
+	}
+
+	x := v.core()
+	x.Prerelease = make([]string, len(v.Prerelease))
+	copy(x.Prerelease, v.Prerelease)
+	return incPrerelease(x, identifier)
+}
+
+// incPrerelease increments the prerelease of x, and returns x.
+func incPrerelease(x *Version, identifier string) (*Version, error) {
+	if identifier != "" && !isValidPrereleaseIdentifier(identifier) {
+		return nil, ErrorInvalidPrereleaseIdentifier
+	}
+
+	incremented := false
+	for i := len(x.Prerelease) - 1; i >= 0; i -= 1 {
+		if isStringNumeric(x.Prerelease[i]) {
+			x.Prerelease[i] = incrementNumericIdentifier(x.Prerelease[i])
+			incremented = true
+			break
+		}
+	}
+	if !incremented {
+		x.Prerelease = append(x.Prerelease, "0")
+	}
+
+	// `1.2.0-beta.1` becomes `1.2.0-beta.2`, but `1.2.0-beta.foo` and `1.2.0-beta` become `1.2.0-beta.0`
+	if identifier != "" && (x.Prerelease[0] != identifier || len(x.Prerelease) < 2 || !isStringNumeric(x.Prerelease[1])) {
+		x.Prerelease = []string{identifier, "0"}
+	}
+
+	return x, nil
+}
+
+func isValidPrereleaseIdentifier(identifier string) bool {
+	for _, char := range identifier {
+		if !isAlphanumericIdentifier(char) {
+			return false
+		}
+	}
+	return !(len(identifier) > 1 && identifier[0] == '0' && isStringNumeric(identifier))
+}
+
+// incrementNumericIdentifier adds one to a numeric identifier of any size.
+func incrementNumericIdentifier(in string) string {
+	x := []byte(in)
+	for i := len(x) - 1; i >= 0; i -= 1 {
+		if x[i] != '9' {
+			x[i] += 1
+			return string(x)
+		}
+		x[i] = '0'
+	}
+	return "1" + string(x)
+}
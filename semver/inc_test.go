@@ -0,0 +1,105 @@
+package semver
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+// most test cases taken from https://github.com/npm/node-semver/blob/main/test/fixtures/increments.js
+
+func TestVersion_Inc(t *testing.T) {
+	tests := []struct {
+		version string
+		inc     func(v *Version) *Version
+		want    string
+	}{
+		{"1.2.3", (*Version).IncMajor, "2.0.0"},
+		{"1.2.3-4", (*Version).IncMajor, "2.0.0"},
+		{"1.0.0-4", (*Version).IncMajor, "1.0.0"},
+		{"1.2.0-4", (*Version).IncMajor, "2.0.0"},
+		{"0.2.3+build", (*Version).IncMajor, "1.0.0"},
+
+		{"1.2.3", (*Version).IncMinor, "1.3.0"},
+		{"1.2.3-4", (*Version).IncMinor, "1.3.0"},
+		{"1.2.0-4", (*Version).IncMinor, "1.2.0"},
+		{"1.2.3+build", (*Version).IncMinor, "1.3.0"},
+
+		{"1.2.3", (*Version).IncPatch, "1.2.4"},
+		{"1.2.3-4", (*Version).IncPatch, "1.2.3"},
+		{"1.2.3-alpha.4+build", (*Version).IncPatch, "1.2.3"},
+
+		{"18446744073709551616.2.3", (*Version).IncMajor, "18446744073709551617.0.0"},
+		{"18446744073709551616.2.3", (*Version).IncMinor, "18446744073709551616.3.0"},
+		{"1.99999999999999999999.3", (*Version).IncMinor, "1.100000000000000000000.0"},
+		{"1.2." + strconv.Itoa(maxInt), (*Version).IncPatch, "1.2." + incrementNumericIdentifier(strconv.Itoa(maxInt))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.version+" "+tt.want, func(t *testing.T) {
+			v := mkv(tt.version)
+			got := tt.inc(v)
+			if !reflect.DeepEqual(got, mkv(tt.want)) {
+				t.Errorf("Inc() = %#v, want %v", got, tt.want)
+			}
+			if v.String() != tt.version {
+				t.Errorf("Inc() modified the version to %v", v)
+			}
+		})
+	}
+}
+
+func TestVersion_IncPre(t *testing.T) {
+	tests := []struct {
+		version    string
+		inc        func(v *Version, identifier string) (*Version, error)
+		identifier string
+		want       string
+		wantErr    error
+	}{
+		{version: "1.2.3", inc: (*Version).IncPremajor, want: "2.0.0-0"},
+		{version: "1.2.3-4", inc: (*Version).IncPremajor, want: "2.0.0-0"},
+		{version: "1.2.3", inc: (*Version).IncPremajor, identifier: "dev", want: "2.0.0-dev.0"},
+		{version: "1.2.3", inc: (*Version).IncPreminor, want: "1.3.0-0"},
+		{version: "1.2.3-alpha", inc: (*Version).IncPreminor, identifier: "dev", want: "1.3.0-dev.0"},
+		{version: "1.2.3", inc: (*Version).IncPrepatch, want: "1.2.4-0"},
+		{version: "1.2.3-1", inc: (*Version).IncPrepatch, want: "1.2.4-0"},
+		{version: "1.2.3", inc: (*Version).IncPrepatch, identifier: "rc", want: "1.2.4-rc.0"},
+
+		{version: "1.2.3", inc: (*Version).IncPrerelease, want: "1.2.4-0"},
+		{version: "1.2.3", inc: (*Version).IncPrerelease, identifier: "rc", want: "1.2.4-rc.0"},
+		{version: "1.2.3-0", inc: (*Version).IncPrerelease, want: "1.2.3-1"},
+		{version: "1.2.3-alpha.4", inc: (*Version).IncPrerelease, want: "1.2.3-alpha.5"},
+		{version: "1.2.3-alpha.4", inc: (*Version).IncPrerelease, identifier: "alpha", want: "1.2.3-alpha.5"},
+		{version: "1.2.3-alpha.9", inc: (*Version).IncPrerelease, want: "1.2.3-alpha.10"},
+		{version: "1.2.3-alpha.1.5", inc: (*Version).IncPrerelease, want: "1.2.3-alpha.1.6"},
+		{version: "1.2.3-alpha.1.bravo", inc: (*Version).IncPrerelease, want: "1.2.3-alpha.2.bravo"},
+		{version: "1.2.3-alpha", inc: (*Version).IncPrerelease, want: "1.2.3-alpha.0"},
+		{version: "1.2.3-alpha", inc: (*Version).IncPrerelease, identifier: "alpha", want: "1.2.3-alpha.0"},
+		{version: "1.2.3-alpha.bravo", inc: (*Version).IncPrerelease, identifier: "alpha", want: "1.2.3-alpha.0"},
+		{version: "1.2.3-alpha.4", inc: (*Version).IncPrerelease, identifier: "beta", want: "1.2.3-beta.0"},
+		{version: "1.2.3-99999999999999999999", inc: (*Version).IncPrerelease, want: "1.2.3-100000000000000000000"},
+		{version: "18446744073709551616.2.3", inc: (*Version).IncPremajor, want: "18446744073709551617.0.0-0"},
+		{version: "18446744073709551616.2.3-rc.1", inc: (*Version).IncPrerelease, want: "18446744073709551616.2.3-rc.2"},
+		{version: "1.2.3-alpha.4+build", inc: (*Version).IncPrerelease, want: "1.2.3-alpha.5"},
+
+		{version: "1.2.3", inc: (*Version).IncPrerelease, identifier: "alpha.1", wantErr: ErrorInvalidPrereleaseIdentifier},
+		{version: "1.2.3", inc: (*Version).IncPremajor, identifier: "01", wantErr: ErrorInvalidPrereleaseIdentifier},
+		{version: "1.2.3", inc: (*Version).IncPrepatch, identifier: "rc_1", wantErr: ErrorInvalidPrereleaseIdentifier},
+	}
+	for _, tt := range tests {
+		t.Run(tt.version+" "+tt.identifier+" "+tt.want, func(t *testing.T) {
+			v := mkv(tt.version)
+			got, err := tt.inc(v, tt.identifier)
+			if err != tt.wantErr {
+				t.Errorf("Inc() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && !reflect.DeepEqual(got, mkv(tt.want)) {
+				t.Errorf("Inc() = %#v, want %v", got, tt.want)
+			}
+			if v.String() != tt.version {
+				t.Errorf("Inc() modified the version to %v", v)
+			}
+		})
+	}
+}
@@ -0,0 +1,498 @@
+package semver
+
+import "sort"
+
+// bound is one end of an interval. A nil version means that the interval is unbounded in that direction.
+type bound struct {
+	version   *Version
+	inclusive bool
+}
+
+// interval is a contiguous range of versions between a lower and upper bound.
+type interval struct {
+	lower, upper bound
+
+	// excluded holds versions within the bounds that are not part of the interval, from `!=` comparators. It is
+	// sorted and has no duplicates.
+	excluded []*Version
+}
+
+var unboundedInterval = interval{}
+
+// compareLowerBounds compares two lower bounds. -1 means that a admits lower versions than b, 1 means that b admits
+// lower versions than a and 0 means they are the same.
+func compareLowerBounds(a, b bound) int {
+	switch {
+	case a.version == nil && b.version == nil:
+		return 0
+	case a.version == nil:
+		return -1
+	case b.version == nil:
+		return 1
+	}
+
+	if n := a.version.CompareTo(b.version); n != 0 {
+		return n
+	}
+
+	switch {
+	case a.inclusive == b.inclusive:
+		return 0
+	case a.inclusive:
+		return -1
+	default:
+		return 1
+	}
+}
+
+// compareUpperBounds compares two upper bounds. -1 means that b admits higher versions than a, 1 means that a admits
+// higher versions than b and 0 means they are the same.
+func compareUpperBounds(a, b bound) int {
+	switch {
+	case a.version == nil && b.version == nil:
+		return 0
+	case a.version == nil:
+		return 1
+	case b.version == nil:
+		return -1
+	}
+
+	if n := a.version.CompareTo(b.version); n != 0 {
+		return n
+	}
+
+	switch {
+	case a.inclusive == b.inclusive:
+		return 0
+	case a.inclusive:
+		return 1
+	default:
+		return -1
+	}
+}
+
+// successor returns the lowest version that is greater than v. For a release this is the lowest prerelease of the
+// next patch version, and for a prerelease this is the same prerelease with an extra `0` identifier.
+func successor(v *Version) *Version {
+	if len(v.Prerelease) == 0 {
+		return lowestPrerelease(v.nextCore(2))
+	}
+
+	x := v.core()
+	x.Prerelease = make([]string, len(v.Prerelease), len(v.Prerelease)+1)
+	copy(x.Prerelease, v.Prerelease)
+	x.Prerelease = append(x.Prerelease, "0")
+
+	return x
+}
+
+// isLowestPrerelease returns true if v is the lowest possible prerelease of its version core, eg `1.2.3-0`.
+func isLowestPrerelease(v *Version) bool {
+	return len(v.Prerelease) == 1 && v.Prerelease[0] == "0"
+}
+
+// isMinimum returns true if v is `0.0.0-0`, the lowest possible version.
+func isMinimum(v *Version) bool {
+	return v.Major == 0 && v.Minor == 0 && v.Patch == 0 && isLowestPrerelease(v)
+}
+
+// admitsPrereleasesOf returns true if a comparator using b as a bound would allow prereleases of the version core of v
+// to match (see comparatorSet.check). upper should be set if b is an upper bound.
+func (b bound) admitsPrereleasesOf(v *Version, upper bool) bool {
+	if b.version == nil || len(b.version.Prerelease) == 0 || compareVersionCore(b.version, v) != 0 {
+		return false
+	}
+	// `<1.2.3-0` opts into prereleases of 1.2.3, but none of them are below it
+	return !upper || b.inclusive || !isLowestPrerelease(b.version)
+}
+
+// intervalFromComparator returns the range of versions that are within the bounds of c.
+func intervalFromComparator(c *comparator) interval {
+	switch c.prefix {
+	case "*":
+		return unboundedInterval
+	case "^", "~":
+		return interval{lower: bound{c.version, true}, upper: bound{c.upperbound, false}}
+	case ">":
+		return interval{lower: bound{c.version, false}}
+	case ">=":
+		return interval{lower: bound{c.version, true}}
+	case "<":
+		return interval{upper: bound{c.version, false}}
+	case "<=":
+		return interval{upper: bound{c.version, true}}
+	case "=":
+		return interval{lower: bound{c.version, true}, upper: bound{c.version, true}}
+	case "!=":
+		return interval{excluded: []*Version{c.version}}
+	default:
+		panic("this should never happen")
+	}
+}
+
+// withinBounds returns true if v is between the lower and upper bounds of i, whether or not it has been excluded.
+func (i interval) withinBounds(v *Version) bool {
+	if i.lower.version != nil {
+		if n := v.CompareTo(i.lower.version); n < 0 || (n == 0 && !i.lower.inclusive) {
+			return false
+		}
+	}
+	if i.upper.version != nil {
+		if n := v.CompareTo(i.upper.version); n > 0 || (n == 0 && !i.upper.inclusive) {
+			return false
+		}
+	}
+	return true
+}
+
+// has returns true if v is part of the interval.
+func (i interval) has(v *Version) bool {
+	if !i.withinBounds(v) {
+		return false
+	}
+	for _, x := range i.excluded {
+		if x.CompareTo(v) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// excludedFrom returns the versions from each of the lists given that are within the bounds of i and are not part of
+// any of the intervals in outside, sorted and without duplicates.
+func (i interval) excludedFrom(lists [][]*Version, outside ...interval) []*Version {
+	var x Slice
+
+	for _, list := range lists {
+	versionLoop:
+		for _, v := range list {
+			if !i.withinBounds(v) {
+				continue
+			}
+			for _, o := range outside {
+				if o.has(v) {
+					continue versionLoop
+				}
+			}
+			x = append(x, v)
+		}
+	}
+
+	sort.Sort(x)
+
+	var n int
+	for _, v := range x {
+		if n == 0 || x[n-1].CompareTo(v) != 0 {
+			x[n] = v
+			n += 1
+		}
+	}
+
+	return x[:n]
+}
+
+// intersect returns the versions that are in both i and ix.
+func (i interval) intersect(ix interval) interval {
+	x := interval{lower: i.lower, upper: i.upper}
+	if compareLowerBounds(ix.lower, x.lower) == 1 {
+		x.lower = ix.lower
+	}
+	if compareUpperBounds(ix.upper, x.upper) == -1 {
+		x.upper = ix.upper
+	}
+	x.excluded = x.excludedFrom([][]*Version{i.excluded, ix.excluded})
+	return x
+}
+
+// lowest returns the lowest version that is within the bounds of i. An exclusive lower bound is the same as an
+// inclusive bound on the next version up.
+func (i interval) lowest() *Version {
+	switch {
+	case i.lower.version == nil:
+		return lowestPrerelease(new(Version))
+	case i.lower.inclusive:
+		return i.lower.version
+	default:
+		return successor(i.lower.version)
+	}
+}
+
+// isEmpty returns true if there are no versions within the interval.
+func (i interval) isEmpty() bool {
+	if i.upper.version == nil {
+		return false
+	}
+
+	// every version that isn't part of the interval but is within its bounds has been excluded, so there can be at
+	// most len(i.excluded) of them at the bottom of the interval
+	v := i.lowest()
+	for n := 0; n <= len(i.excluded); n++ {
+		if !i.withinBounds(v) {
+			return true
+		}
+		if i.has(v) {
+			return false
+		}
+		v = successor(v)
+	}
+
+	return false
+}
+
+// touches returns true if there is no version between the upper bound of i and the lower bound of ix, meaning that
+// the two can be joined into one interval. ix must not have a lower bound that is lower than that of i.
+func (i interval) touches(ix interval) bool {
+	if i.upper.version == nil || ix.lower.version == nil {
+		return true
+	}
+
+	switch i.upper.version.CompareTo(ix.lower.version) {
+	case 1:
+		return true
+	case 0:
+		return i.upper.inclusive || ix.lower.inclusive
+	default:
+		return i.upper.inclusive && ix.lower.inclusive && successor(i.upper.version).CompareTo(ix.lower.version) == 0
+	}
+}
+
+// complement returns the versions that are not part of any of the normalised intervals given, as the gaps between
+// them and a single version interval for each excluded version.
+func complement(intervals []interval) []interval {
+	if len(intervals) == 0 {
+		return []interval{unboundedInterval}
+	}
+
+	var x []interval
+
+	if first := intervals[0].lower; first.version != nil {
+		x = append(x, interval{upper: bound{first.version, !first.inclusive}})
+	}
+
+	for n, i := range intervals {
+		for _, v := range i.excluded {
+			x = append(x, interval{lower: bound{v, true}, upper: bound{v, true}})
+		}
+
+		if n+1 < len(intervals) {
+			next := intervals[n+1].lower
+			x = append(x, interval{lower: bound{i.upper.version, !i.upper.inclusive}, upper: bound{next.version, !next.inclusive}})
+		}
+	}
+
+	if last := intervals[len(intervals)-1].upper; last.version != nil {
+		x = append(x, interval{lower: bound{last.version, !last.inclusive}})
+	}
+
+	return x
+}
+
+// comparators returns the shortest comparator set that matches the same versions as i. If keepPrereleases is set, an
+// inclusive lower bound of `0.0.0-0` is kept, as without it prereleases of 0.0.0 would not match.
+func (i interval) comparators(keepPrereleases bool) comparatorSet {
+	if !keepPrereleases && i.lower.version != nil && isMinimum(i.lower.version) && i.lower.inclusive {
+		i.lower.version = nil
+	}
+
+	switch {
+	case i.lower.version == nil && i.upper.version == nil && len(i.excluded) == 0:
+		return comparatorSet{{prefix: "*"}}
+	case i.lower.version != nil && i.upper.version != nil && i.lower.inclusive && i.upper.inclusive && i.lower.version.CompareTo(i.upper.version) == 0:
+		return comparatorSet{{prefix: "=", version: i.lower.version}}
+	}
+
+	var set comparatorSet
+
+	if i.lower.version != nil {
+		prefix := ">"
+		if i.lower.inclusive {
+			prefix = ">="
+		}
+		set = append(set, &comparator{prefix: prefix, version: i.lower.version})
+	}
+
+	if i.upper.version != nil {
+		prefix := "<"
+		if i.upper.inclusive {
+			prefix = "<="
+		}
+		set = append(set, &comparator{prefix: prefix, version: i.upper.version})
+	}
+
+	for _, v := range i.excluded {
+		set = append(set, &comparator{prefix: "!=", version: v})
+	}
+
+	return set
+}
+
+// intervalFromSet returns the range of versions that are within the bounds of every comparator in s.
+func intervalFromSet(s comparatorSet) interval {
+	i := unboundedInterval
+	for _, c := range s {
+		i = i.intersect(intervalFromComparator(c))
+	}
+	return i
+}
+
+// normaliseIntervals removes any empty intervals and joins together any that overlap or touch. The result is sorted
+// by lower bound.
+//
+// If keepPrereleases is set, intervals are not joined if doing so would drop a bound that allows prereleases to be
+// matched when IncludePrerelease is not set, which means that the comparator sets made from the result match exactly the
+// same versions as the intervals did.
+func normaliseIntervals(intervals []interval, keepPrereleases bool) []interval {
+	var x []interval
+	for _, i := range intervals {
+		if !i.isEmpty() {
+			x = append(x, i)
+		}
+	}
+
+	sort.SliceStable(x, func(i, j int) bool {
+		return compareLowerBounds(x[i].lower, x[j].lower) == -1
+	})
+
+	var n int
+	for _, i := range x {
+		if n == 0 {
+			x[n] = i
+			n += 1
+			continue
+		}
+
+		joined := interval{lower: x[n-1].lower, upper: x[n-1].upper}
+		if joined.lower.version == nil && i.lower.version != nil && i.lower.inclusive && isMinimum(i.lower.version) {
+			// these are the same set of versions, but only `>=0.0.0-0` allows prereleases of 0.0.0 to match
+			joined.lower = i.lower
+		}
+		if compareUpperBounds(i.upper, joined.upper) == 1 {
+			joined.upper = i.upper
+		}
+		joined.excluded = joined.excludedFrom([][]*Version{x[n-1].excluded, i.excluded}, x[n-1], i)
+
+		switch {
+		case !keepPrereleases:
+			if x[n-1].touches(i) {
+				x[n-1] = joined
+				continue
+			}
+		case x[n-1].touches(i) || x[n-1].onlyPrereleasesBefore(i, joined):
+			if joined.keepsPrereleasesOf(x[n-1]) && joined.keepsPrereleasesOf(i) {
+				x[n-1] = joined
+				continue
+			}
+		}
+
+		x[n] = i
+		n += 1
+	}
+
+	return x[:n]
+}
+
+// onlyPrereleasesBefore returns true if every version between the upper bound of i and the lower bound of ix is a
+// prerelease that would not be matched by joined, for example between `<1.3.0-0` and `>=1.3.0`.
+func (i interval) onlyPrereleasesBefore(ix interval, joined interval) bool {
+	if i.upper.version == nil || ix.lower.version == nil || compareVersionCore(i.upper.version, ix.lower.version) != 0 {
+		return false
+	}
+	v := ix.lower.version
+	return !joined.lower.admitsPrereleasesOf(v, false) && !joined.upper.admitsPrereleasesOf(v, true)
+}
+
+// keepsPrereleasesOf returns true if the bounds of i allow all of the prereleases that the bounds of ix do, assuming i
+// contains ix.
+func (i interval) keepsPrereleasesOf(ix interval) bool {
+	keeps := func(b bound, upper bool) bool {
+		if !b.admitsPrereleasesOf(b.version, upper) {
+			return true
+		}
+		return i.lower.admitsPrereleasesOf(b.version, false) || i.upper.admitsPrereleasesOf(b.version, true)
+	}
+	return keeps(ix.lower, false) && keeps(ix.upper, true)
+}
+
+// intervals returns the normalised ranges of versions within the bounds of the constraint.
+func (c *Constraint) intervals(keepPrereleases bool) []interval {
+	x := make([]interval, len(c.sets))
+	for i, set := range c.sets {
+		x[i] = intervalFromSet(set)
+	}
+	return normaliseIntervals(x, keepPrereleases)
+}
+
+// constraintFromIntervals creates a Constraint from a set of normalised intervals. keepPrereleases should be the same as
+// was given to normaliseIntervals.
+func constraintFromIntervals(intervals []interval, options ConstraintOptions, keepPrereleases bool) *Constraint {
+	c := &Constraint{options: options}
+
+	if len(intervals) == 0 {
+		// nothing is less than the lowest possible version
+		c.sets = []comparatorSet{{{prefix: "<", version: lowestPrerelease(new(Version))}}}
+		return c
+	}
+
+	for _, i := range intervals {
+		c.sets = append(c.sets, i.comparators(keepPrereleases))
+	}
+
+	return c
+}
+
+// The set operations below treat each constraint as the ranges of versions between its bounds, as if IncludePrerelease
+// were set. Results take the options of the receiver.
+
+// Intersect returns a constraint matching the versions that are matched by both c and cx. For example, the
+// intersection of `^1.2.0` and `>=1.4.0 <1.9.0` is `>=1.4.0 <1.9.0`.
+func (c *Constraint) Intersect(cx *Constraint) *Constraint {
+	other := cx.intervals(false)
+
+	var x []interval
+	for _, i := range c.intervals(false) {
+		for _, ix := range other {
+			x = append(x, i.intersect(ix))
+		}
+	}
+	return constraintFromIntervals(normaliseIntervals(x, false), c.options, false)
+}
+
+// Union returns a constraint matching the versions that are matched by either c or cx.
+func (c *Constraint) Union(cx *Constraint) *Constraint {
+	return constraintFromIntervals(normaliseIntervals(append(c.intervals(false), cx.intervals(false)...), false), c.options, false)
+}
+
+// IsSubsetOf returns true if every version matched by c is also matched by cx.
+func (c *Constraint) IsSubsetOf(cx *Constraint) bool {
+	outside := complement(cx.intervals(false))
+
+	for _, i := range c.intervals(false) {
+		for _, o := range outside {
+			if !i.intersect(o).isEmpty() {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Overlaps returns true if there is at least one version that is matched by both c and cx.
+func (c *Constraint) Overlaps(cx *Constraint) bool {
+	return !c.Intersect(cx).IsEmpty()
+}
+
+// IsEmpty returns true if the constraint can never be satisfied, for example `>2.0.0 <1.0.0`.
+func (c *Constraint) IsEmpty() bool {
+	return len(c.intervals(false)) == 0
+}
+
+// Simplify returns the shortest constraint that matches the same versions as c. Redundant comparators are removed,
+// overlapping comparator sets are joined and everything is written in terms of `>`, `>=`, `<`, `<=` and exact matches,
+// so two constraints that match the same versions have the same String.
+//
+// Comparator sets are not joined if that would change which prereleases match, unless IncludePrerelease is set.
+func (c *Constraint) Simplify() *Constraint {
+	keepPrereleases := !c.options.IncludePrerelease
+	return constraintFromIntervals(c.intervals(keepPrereleases), c.options, keepPrereleases)
+}
@@ -0,0 +1,256 @@
+package semver
+
+import "testing"
+
+func TestConstraint_Intersect(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want string
+	}{
+		{a: "^1.2.0", b: ">=1.4.0 <1.9.0", want: ">=1.4.0 <1.9.0"},
+		{a: "^1.2.0", b: ">=1.4.0", want: ">=1.4.0 <2.0.0-0"},
+		{a: "~1.2.3", b: "^1.0.0", want: ">=1.2.3 <1.3.0-0"},
+		{a: "1.x || 3.x", b: ">=1.5.0 <3.2.0", want: ">=1.5.0 <2.0.0-0 || >=3.0.0 <3.2.0"},
+		{a: "*", b: "*", want: "*"},
+		{a: ">=1.0.0 <=2.0.0", b: ">=2.0.0", want: "2.0.0"},
+		{a: "^1.0.0", b: "^2.0.0", want: "<0.0.0-0"},
+		{a: "<1.0.0", b: ">1.0.0", want: "<0.0.0-0"},
+		{a: "^1.0.0 !=1.2.0", b: ">=1.1.0 !=1.3.0", want: ">=1.1.0 <2.0.0-0 !=1.2.0 !=1.3.0"},
+		{a: "^1.0.0", b: "!=2.0.0", want: ">=1.0.0 <2.0.0-0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+" & "+tt.b, func(t *testing.T) {
+			if got := MustParseConstraint(tt.a).Intersect(MustParseConstraint(tt.b)).String(); got != tt.want {
+				t.Errorf("Constraint.Intersect() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConstraint_Union(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want string
+	}{
+		{a: "^1.2.0", b: "^1.5.0", want: ">=1.2.0 <2.0.0-0"},
+		{a: "^1.2.0", b: "^2.0.0", want: ">=1.2.0 <2.0.0-0 || >=2.0.0 <3.0.0-0"},
+		{a: "^1.2.0", b: "^2.0.0-0", want: ">=1.2.0 <3.0.0-0"},
+		{a: ">=1.0.0 <2.0.0", b: ">2.0.0", want: ">=1.0.0 <2.0.0 || >2.0.0"},
+		{a: ">=1.0.0 <2.0.0", b: ">=2.0.0", want: ">=1.0.0"},
+		{a: "<=1.0.0", b: ">1.0.0", want: "*"},
+		{a: "3.x", b: "1.x", want: ">=1.0.0 <2.0.0-0 || >=3.0.0 <4.0.0-0"},
+		{a: "1.0.0", b: "1.0.0", want: "1.0.0"},
+		{a: "<=1.0.0", b: ">=1.0.1-0", want: "*"},
+		{a: "!=1.0.0 !=2.0.0", b: "1.0.0", want: "!=2.0.0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+" | "+tt.b, func(t *testing.T) {
+			if got := MustParseConstraint(tt.a).Union(MustParseConstraint(tt.b)).String(); got != tt.want {
+				t.Errorf("Constraint.Union() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConstraint_IsSubsetOf(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{a: "^1.5.0", b: "^1.2.0", want: true},
+		{a: "^1.2.0", b: "^1.5.0", want: false},
+		{a: "1.2.3", b: "^1.0.0", want: true},
+		{a: "~1.2.0 || ~1.4.0", b: "^1.0.0", want: true},
+		{a: "^1.0.0", b: ">=1.0.0 <1.5.0 || >=1.5.0 <2.0.0-0", want: true},
+		{a: "^1.0.0", b: ">=1.0.0 <1.5.0 || >1.5.0", want: false},
+		{a: "*", b: "^1.0.0", want: false},
+		{a: ">2.0.0 <1.0.0", b: "1.0.0", want: true},
+		{a: ">=1.0.0 <3.0.0 !=2.0.0", b: ">=1.0.0 <2.0.0 || >2.0.0 <3.0.0", want: true},
+		{a: ">=1.0.0 <2.0.0 || >2.0.0 <3.0.0", b: ">=1.0.0 <3.0.0 !=2.0.0", want: true},
+		{a: ">=1.0.0 <3.0.0 !=2.0.0", b: ">=1.0.0 <2.0.0 || >2.0.1 <3.0.0", want: false},
+		{a: ">=1.0.0 <3.0.0", b: ">=1.0.0 <3.0.0 !=2.0.0", want: false},
+		{a: ">=1.2.3-0 <=1.2.3-0.0 !=1.2.3-0 !=1.2.3-0.0", b: "2.0.0", want: true},
+		{a: "*", b: "1.0.0 || !=1.0.0 !=2.0.0", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+" in "+tt.b, func(t *testing.T) {
+			if got := MustParseConstraint(tt.a).IsSubsetOf(MustParseConstraint(tt.b)); got != tt.want {
+				t.Errorf("Constraint.IsSubsetOf() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConstraint_Overlaps(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{a: "^1.2.0", b: ">=1.9.0", want: true},
+		{a: "^1.2.0", b: "^2.0.0", want: false},
+		{a: "<=1.0.0", b: ">=1.0.0", want: true},
+		{a: "<1.0.0", b: ">=1.0.0", want: false},
+		{a: "1.x || 3.x", b: "3.1.4", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+" & "+tt.b, func(t *testing.T) {
+			if got := MustParseConstraint(tt.a).Overlaps(MustParseConstraint(tt.b)); got != tt.want {
+				t.Errorf("Constraint.Overlaps() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConstraint_IsEmpty(t *testing.T) {
+	tests := []struct {
+		args string
+		want bool
+	}{
+		{args: "*", want: false},
+		{args: "1.0.0", want: false},
+		{args: ">2.0.0 <1.0.0", want: true},
+		{args: ">1.0.0 <1.0.0", want: true},
+		{args: ">=1.0.0 <1.0.0", want: true},
+		{args: ">1.0.0 <=1.0.0", want: true},
+		{args: ">=1.0.0 <=1.0.0", want: false},
+		{args: ">1.0.0 <1.0.1-0", want: true},
+		{args: ">1.0.0 <1.0.1", want: false},
+		{args: ">1.0.0-alpha <1.0.0-alpha.0", want: true},
+		{args: ">1.0.18446744073709551616 <1.0.18446744073709551617-0", want: true},
+		{args: ">1.0.18446744073709551616 <1.0.18446744073709551617", want: false},
+		{args: ">1.0.18446744073709551616-rc <1.0.18446744073709551616-rc.0", want: true},
+		{args: "<0.0.0-0", want: true},
+		{args: ">*", want: true},
+		{args: ">2.0.0 <1.0.0 || 1.0.0", want: false},
+		{args: "1.0.0 !=1.0.0", want: true},
+		{args: ">=1.0.0 <=1.0.0 !=1.0.0", want: true},
+		{args: ">=1.0.0 <1.0.1-0 !=1.0.0", want: true},
+		{args: ">=1.0.0 <1.0.1 !=1.0.0", want: false},
+		{args: "!=1.0.0", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.args, func(t *testing.T) {
+			if got := MustParseConstraint(tt.args).IsEmpty(); got != tt.want {
+				t.Errorf("Constraint.IsEmpty() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConstraint_Simplify(t *testing.T) {
+	tests := []struct {
+		args string
+		want string
+	}{
+		{args: ">=1.0.0 >=1.2.0 <3.0.0 <2.5.0 || ^1.3.0", want: ">=1.2.0 <2.5.0"},
+		{args: "^1.2.3", want: ">=1.2.3 <2.0.0-0"},
+		{args: "~1.2.3 || ~1.2.5 || 1.3.x", want: ">=1.2.3 <1.4.0-0"},
+		{args: "=1.0.0 >=0.5.0", want: "1.0.0"},
+		{args: "1.0.0 || 1.0.0", want: "1.0.0"},
+		{args: "2.x || 1.x", want: ">=1.0.0 <3.0.0-0"},
+		{args: ">=1.0.0 <=2.0.0 || >2.0.0", want: ">=1.0.0"},
+		{args: ">=0.0.0-0", want: ">=0.0.0-0"},
+		{args: ">=0.0.0", want: ">=0.0.0"},
+		{args: "* || 1.2.3", want: "*"},
+		{args: ">2.0.0 <1.0.0", want: "<0.0.0-0"},
+		{args: ">2.0.0 <1.0.0 || 1.2.3", want: "1.2.3"},
+		{args: "^1.0.0 !=1.2.0 !=3.0.0 !=1.2.0", want: ">=1.0.0 <2.0.0-0 !=1.2.0"},
+		{args: "^1.0.0 !=1.2.0 || 1.2.0", want: ">=1.0.0 <2.0.0-0"},
+		{args: "^1.0.0 !=1.2.0 || ^1.1.0 !=1.3.0", want: ">=1.0.0 <2.0.0-0"},
+		{args: "^1.0.0 !=1.2.0 || ^1.3.0", want: ">=1.0.0 <2.0.0-0 !=1.2.0"},
+		{args: "^1.0.0 !=1.2.0 || ^1.1.0 !=1.2.0", want: ">=1.0.0 <2.0.0-0 !=1.2.0"},
+		{args: "!=1.0.0", want: "!=1.0.0"},
+		{args: "1.0.0 || !=1.0.0 !=2.0.0", want: "!=2.0.0"},
+		{args: "1.0.0 || !=2.0.0 !=1.0.0 !=3.0.0", want: "!=2.0.0 !=3.0.0"},
+
+		// comparator sets that allow different prereleases are kept apart
+		{args: ">=1.0.0 <2.0.0-rc.1 || >=1.5.0 <3.0.0", want: ">=1.0.0 <2.0.0-rc.1 || >=1.5.0 <3.0.0"},
+		{args: ">=1.0.0 <3.0.0 || ~1.5.0-rc.1", want: ">=1.0.0 <3.0.0 || >=1.5.0-rc.1 <1.6.0-0"},
+		{args: ">=1.0.0-rc.1 <3.0.0 || >=1.0.0-rc.3 <1.5.0", want: ">=1.0.0-rc.1 <3.0.0"},
+		{args: ">=0.5.0-rc.1 <1.0.0-0 || >=1.0.0 <2.0.0", want: ">=0.5.0-rc.1 <2.0.0"},
+		{args: ">=0.5.0 <1.0.0-rc.2 || >=1.0.0 <2.0.0", want: ">=0.5.0 <1.0.0-rc.2 || >=1.0.0 <2.0.0"},
+		{args: "~0.0.0-0 || <=0.2", want: ">=0.0.0-0 <0.3.0-0"},
+		{args: "<1.0.0 || >=0.0.0-0 <0.1.0", want: ">=0.0.0-0 <1.0.0"},
+	}
+
+	options := ft("").options
+	for _, x := range []string{"0.0.0-0", "0.0.0-alpha", "0.0.1-rc.1", "0.1.0-0", "0.2.5-beta", "0.3.0-0", "1.0.0-0", "1.2.0-rc.1", "1.5.0-rc.1", "1.5.0-rc.2", "2.0.0-0", "2.0.0-rc.1", "2.0.0-rc.2"} {
+		options = append(options, mkv(x))
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.args, func(t *testing.T) {
+			c := MustParseConstraint(tt.args)
+			got := c.Simplify()
+			if got.String() != tt.want {
+				t.Errorf("Constraint.Simplify() = %v, want %v", got, tt.want)
+			}
+
+			// simplifying must not change what is matched
+			for _, v := range options {
+				if c.Check(v) != got.Check(v) {
+					t.Errorf("Constraint.Simplify().Check(%s) = %v, want %v", v, got.Check(v), c.Check(v))
+				}
+			}
+		})
+	}
+
+	t.Run("IncludePrerelease", func(t *testing.T) {
+		c, _ := ParseConstraintWithOptions(">=1.0.0 <2.0.0-rc.1 || >=1.5.0 <3.0.0", ConstraintOptions{IncludePrerelease: true})
+		if got, want := c.Simplify().String(), ">=1.0.0 <3.0.0"; got != want {
+			t.Errorf("Constraint.Simplify() = %v, want %v", got, want)
+		}
+
+		c, _ = ParseConstraintWithOptions(">=0.0.0-0", ConstraintOptions{IncludePrerelease: true})
+		if got, want := c.Simplify().String(), "*"; got != want {
+			t.Errorf("Constraint.Simplify() = %v, want %v", got, want)
+		}
+	})
+}
+
+func TestConstraint_SetOperationsMatchCheck(t *testing.T) {
+	constraints := []string{"*", "^1.2.0", "~1.2.0 || ~1.4.0", ">=1.0.0 <3.0.0 !=2.0.0", ">=1.0.0 <2.0.0 || >2.0.0 <3.0.0",
+		"1.0.0 || !=1.0.0 !=2.0.0", "!=1.0.0 !=2.0.0", "!=2.0.0", "1.0.0", "2.0.0", ">2.0.0 <1.0.0", "<=1.0.0 || >=2.0.0",
+		"^2.0.0-0 !=2.1.0", ">=1.0.0-rc.1 <1.2.0 !=1.0.0 !=1.1.0"}
+	versions := []string{"0.0.0-0", "0.1.0", "1.0.0-rc.1", "1.0.0-rc.2", "1.0.0", "1.0.1", "1.1.0", "1.2.0", "1.2.5", "1.3.0",
+		"1.4.0", "1.4.1", "1.9.9", "2.0.0-0", "2.0.0-rc.1", "2.0.0", "2.0.1", "2.1.0", "2.5.0", "3.0.0", "4.0.0"}
+
+	options := ConstraintOptions{IncludePrerelease: true}
+	parse := func(x string) *Constraint {
+		c, err := ParseConstraintWithOptions(x, options)
+		if err != nil {
+			t.Fatalf("ParseConstraintWithOptions(%q) error = %v", x, err)
+		}
+		return c
+	}
+
+	for _, a := range constraints {
+		ca := parse(a)
+
+		simplified := ca.Simplify()
+		for _, x := range versions {
+			if v := mkv(x); simplified.Check(v) != ca.Check(v) {
+				t.Errorf("%q.Simplify().Check(%s) = %v, want %v", a, x, simplified.Check(v), ca.Check(v))
+			}
+		}
+
+		for _, b := range constraints {
+			cb := parse(b)
+			intersection, union := ca.Intersect(cb), ca.Union(cb)
+			subset := ca.IsSubsetOf(cb)
+
+			for _, x := range versions {
+				v := mkv(x)
+				if got, want := intersection.Check(v), ca.Check(v) && cb.Check(v); got != want {
+					t.Errorf("%q.Intersect(%q).Check(%s) = %v, want %v", a, b, x, got, want)
+				}
+				if got, want := union.Check(v), ca.Check(v) || cb.Check(v); got != want {
+					t.Errorf("%q.Union(%q).Check(%s) = %v, want %v", a, b, x, got, want)
+				}
+				if subset && ca.Check(v) && !cb.Check(v) {
+					t.Errorf("%q.IsSubsetOf(%q) = true, but %s only matches the first", a, b, x)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,193 @@
+package semver
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+var ErrorNoVersionFound = errors.New("semver: Coerce: no version found")
+
+// Normalization is a set of flags that describe the changes that ParseLoose and Coerce had to make to their input to
+// turn it into a valid version.
+type Normalization uint8
+
+const (
+	// NormalizedWhitespace means that leading or trailing whitespace was removed.
+	NormalizedWhitespace Normalization = 1 << iota
+	// NormalizedPrefix means that a leading `v`, `V` or `=` was removed.
+	NormalizedPrefix
+	// NormalizedMissingComponents means that a missing minor or patch version was filled with a zero, eg `1.2`.
+	NormalizedMissingComponents
+	// NormalizedExtraComponents means that any components after the patch version were removed, eg `1.2.3.4`.
+	NormalizedExtraComponents
+	// NormalizedLeadingZeros means that leading zeros were removed from the version core, eg `1.02.3`.
+	NormalizedLeadingZeros
+	// NormalizedPrereleaseSeparator means that a `-` was added before a prerelease that immediately followed the
+	// version core, eg `1.2.3rc1`.
+	NormalizedPrereleaseSeparator
+	// NormalizedExtracted means that the version was found within other text, which was discarded along with any
+	// prerelease or build metadata.
+	NormalizedExtracted
+)
+
+var normalizationNames = []string{"whitespace", "prefix", "missing components", "extra components", "leading zeros", "prerelease separator", "extracted"}
+
+// Has returns true if every flag in x is set in n.
+func (n Normalization) Has(x Normalization) bool {
+	return n&x == x
+}
+
+func (n Normalization) String() string {
+	if n == 0 {
+		return "none"
+	}
+
+	var names []string
+	for i, name := range normalizationNames {
+		if n&(1<<uint(i)) != 0 {
+			names = append(names, name)
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+// ParseLoose parses a version that may not strictly follow the specification, like those commonly found in tags. It
+// accepts surrounding whitespace, a leading `=`, `v` or `=v`, a missing minor or patch version, components after the
+// patch version (which are discarded), leading zeros in the version core and a prerelease without a preceding `-`.
+//
+// The returned Normalization describes what was changed so that callers can warn about it. If the input was already
+// a valid version, it is zero.
+func ParseLoose(in string) (*Version, Normalization, error) {
+	var n Normalization
+
+	s := strings.TrimSpace(in)
+	if s != in {
+		n |= NormalizedWhitespace
+	}
+
+	// as in node-semver, there can be one `=`, then one `v`
+	trimmed := s
+	if strings.HasPrefix(trimmed, "=") {
+		trimmed = strings.TrimLeft(trimmed[1:], " \t")
+	}
+	if strings.HasPrefix(trimmed, "v") || strings.HasPrefix(trimmed, "V") {
+		trimmed = trimmed[1:]
+	}
+	if trimmed != s {
+		n |= NormalizedPrefix
+		s = trimmed
+	}
+
+	// start is the offset of s in the input, used to make sure that any errors point to the right place
+	start := strings.Index(in, s)
+
+	coreLength := strings.IndexFunc(s, func(char rune) bool { return !(isDigit(char) || char == '.') })
+	if coreLength == -1 {
+		coreLength = len(s)
+	}
+	core, rest := s[:coreLength], s[coreLength:]
+
+	if rest != "" && rest[0] != '-' && rest[0] != '+' {
+		if !isLetter(rune(rest[0])) {
+			return nil, n, newParseError(in, start+coreLength, ComponentCore, ParseErrorUnrecognisedCharacter)
+		}
+		rest = "-" + rest
+		n |= NormalizedPrereleaseSeparator
+	}
+
+	components := strings.Split(core, ".")
+	offset := start
+	for _, component := range components {
+		if component == "" {
+			return nil, n, newParseError(in, offset, ComponentCore, ParseErrorIncompleteVersionCore)
+		}
+		offset += len(component) + 1
+	}
+
+	components, cn := normaliseCore(components)
+	n |= cn
+
+	normalised := strings.Join(components, ".") + rest
+	v, err := Parse(normalised)
+	if err != nil {
+		if pe, ok := err.(*ParseError); ok {
+			// the error refers to the normalised version, but it needs to point to the same place in the input
+			offset := start
+			if pe.Component != ComponentCore {
+				offset = pe.Offset - (len(normalised) - len(rest))
+				if n.Has(NormalizedPrereleaseSeparator) && offset != 0 {
+					offset -= 1
+				}
+				offset += start + coreLength
+			}
+			err = newParseError(in, offset, pe.Component, pe.Code)
+		}
+		return nil, n, err
+	}
+	return v, n, nil
+}
+
+var coercibleVersion = regexp.MustCompile(`(\d+)(?:\.(\d+))?(?:\.(\d+))?`)
+
+// Coerce finds the first thing that looks like a version in some text, such as a file name, and returns it as a
+// version. Only the version core is used - anything else, including a prerelease or build metadata, is discarded. For
+// example, `myapp-release-2.4.tar.gz` is coerced to `2.4.0`.
+//
+// If no version can be found, ErrorNoVersionFound is returned.
+func Coerce(in string) (*Version, Normalization, error) {
+	match := coercibleVersion.FindStringSubmatchIndex(in)
+	if match == nil {
+		return nil, 0, ErrorNoVersionFound
+	}
+
+	var n Normalization
+	if match[0] != 0 || match[1] != len(in) {
+		n |= NormalizedExtracted
+	}
+
+	var components []string
+	for i := 2; i < len(match); i += 2 {
+		if match[i] != -1 {
+			components = append(components, in[match[i]:match[i+1]])
+		}
+	}
+
+	components, cn := normaliseCore(components)
+	n |= cn
+
+	v, err := Parse(strings.Join(components, "."))
+	if err != nil {
+		return nil, n, err
+	}
+	return v, n, nil
+}
+
+// normaliseCore turns a list of numeric components into the three components of a version core.
+func normaliseCore(components []string) ([]string, Normalization) {
+	var n Normalization
+
+	if len(components) > 3 {
+		components = components[:3]
+		n |= NormalizedExtraComponents
+	}
+
+	out := make([]string, 3)
+	for i := range out {
+		if i >= len(components) {
+			out[i] = "0"
+			n |= NormalizedMissingComponents
+			continue
+		}
+
+		out[i] = strings.TrimLeft(components[i], "0")
+		if out[i] == "" {
+			out[i] = "0"
+		}
+		if out[i] != components[i] {
+			n |= NormalizedLeadingZeros
+		}
+	}
+
+	return out, n
+}
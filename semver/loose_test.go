@@ -0,0 +1,106 @@
+package semver
+
+import "testing"
+
+func TestParseLoose(t *testing.T) {
+	tests := []struct {
+		args    string
+		want    string
+		wantN   Normalization
+		wantErr bool
+	}{
+		{args: "1.2.3", want: "1.2.3"},
+		{args: "1.2.3-rc.1+build", want: "1.2.3-rc.1+build"},
+		{args: "v1.2.3", want: "1.2.3", wantN: NormalizedPrefix},
+		{args: "=1.2.3", want: "1.2.3", wantN: NormalizedPrefix},
+		{args: "= v1.2.3", want: "1.2.3", wantN: NormalizedPrefix},
+		{args: "V1.2.3-rc1", want: "1.2.3-rc1", wantN: NormalizedPrefix},
+		{args: "  1.2.3 ", want: "1.2.3", wantN: NormalizedWhitespace},
+		{args: "1.2", want: "1.2.0", wantN: NormalizedMissingComponents},
+		{args: "1", want: "1.0.0", wantN: NormalizedMissingComponents},
+		{args: "1.2-beta", want: "1.2.0-beta", wantN: NormalizedMissingComponents},
+		{args: "1.2.3.4", want: "1.2.3", wantN: NormalizedExtraComponents},
+		{args: "01.002.0", want: "1.2.0", wantN: NormalizedLeadingZeros},
+		{args: "1.2.3rc1", want: "1.2.3-rc1", wantN: NormalizedPrereleaseSeparator},
+		{args: " v2 ", want: "2.0.0", wantN: NormalizedWhitespace | NormalizedPrefix | NormalizedMissingComponents},
+		{args: "v01.18446744073709551616", want: "1.18446744073709551616.0", wantN: NormalizedPrefix | NormalizedMissingComponents | NormalizedLeadingZeros},
+
+		{args: "", wantErr: true},
+		{args: "v", wantErr: true},
+		{args: "1..2", wantErr: true},
+		{args: "1.2.", wantErr: true},
+		{args: "1.2.3_4", wantErr: true},
+		{args: "1.2.3-", wantErr: true},
+		{args: "1.2.3-01", wantErr: true},
+		{args: "version 1.2.3", wantErr: true},
+		{args: "vV==v 1.2.3", wantErr: true},
+		{args: "vv1.2.3", wantErr: true},
+		{args: "==1.2.3", wantErr: true},
+		{args: "v=1.2.3", wantErr: true},
+		{args: "v 1.2.3", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.args, func(t *testing.T) {
+			got, n, err := ParseLoose(tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseLoose() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if got.String() != tt.want {
+				t.Errorf("ParseLoose() = %v, want %v", got, tt.want)
+			}
+			if n != tt.wantN {
+				t.Errorf("ParseLoose() normalization = %v, want %v", n, tt.wantN)
+			}
+		})
+	}
+}
+
+func TestCoerce(t *testing.T) {
+	tests := []struct {
+		args    string
+		want    string
+		wantN   Normalization
+		wantErr bool
+	}{
+		{args: "1.2.3", want: "1.2.3"},
+		{args: "2", want: "2.0.0", wantN: NormalizedMissingComponents},
+		{args: "myapp-release-2.4.tar.gz", want: "2.4.0", wantN: NormalizedExtracted | NormalizedMissingComponents},
+		{args: "v1.2.3-rc.1", want: "1.2.3", wantN: NormalizedExtracted},
+		{args: "1.2.3.4", want: "1.2.3", wantN: NormalizedExtracted},
+		{args: "release 007", want: "7.0.0", wantN: NormalizedExtracted | NormalizedMissingComponents | NormalizedLeadingZeros},
+		{args: "release-1.18446744073709551616.tar.gz", want: "1.18446744073709551616.0", wantN: NormalizedExtracted | NormalizedMissingComponents},
+		{args: "version one", wantErr: true},
+		{args: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.args, func(t *testing.T) {
+			got, n, err := Coerce(tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Coerce() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if got.String() != tt.want {
+				t.Errorf("Coerce() = %v, want %v", got, tt.want)
+			}
+			if n != tt.wantN {
+				t.Errorf("Coerce() normalization = %v, want %v", n, tt.wantN)
+			}
+		})
+	}
+}
+
+func TestNormalization_String(t *testing.T) {
+	if got, want := Normalization(0).String(), "none"; got != want {
+		t.Errorf("Normalization.String() = %v, want %v", got, want)
+	}
+	if got, want := (NormalizedPrefix | NormalizedMissingComponents).String(), "prefix, missing components"; got != want {
+		t.Errorf("Normalization.String() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,115 @@
+package semver
+
+import "errors"
+
+var ErrorNegativeNumber = errors.New("semver: Validate: negative version number")
+
+// New returns a version with the given version core, prerelease identifiers and build identifiers, which can be nil.
+// If the version isn't valid, the error is a *ParseError, as if String had been given to Parse.
+func New(major, minor, patch int, prerelease, build []string) (*Version, error) {
+	v := &Version{Major: major, Minor: minor, Patch: patch, Prerelease: copyIdentifiers(prerelease), Build: copyIdentifiers(build)}
+	if err := v.Validate(); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// MustNew is the same as New, but panics if the version isn't valid.
+func MustNew(major, minor, patch int, prerelease, build []string) *Version {
+	v, err := New(major, minor, patch, prerelease, build)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// WithPrerelease returns a copy of v with its prerelease replaced by identifiers, or removed if there are none. v
+// isn't modified.
+func (v *Version) WithPrerelease(identifiers ...string) (*Version, error) {
+	return v.withIdentifiers(identifiers, v.Build)
+}
+
+// WithBuild returns a copy of v with its build metadata replaced by identifiers, or removed if there are none. v isn't
+// modified.
+func (v *Version) WithBuild(identifiers ...string) (*Version, error) {
+	return v.withIdentifiers(v.Prerelease, identifiers)
+}
+
+// withIdentifiers returns a copy of v with its prerelease and build identifiers replaced.
+func (v *Version) withIdentifiers(prerelease, build []string) (*Version, error) {
+	x := v.core()
+	x.Prerelease, x.Build = copyIdentifiers(prerelease), copyIdentifiers(build)
+	if err := x.Validate(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// copyIdentifiers copies x so that the copy can't be modified through x. An empty slice becomes nil, as it would be
+// from Parse.
+func copyIdentifiers(x []string) []string {
+	if len(x) == 0 {
+		return nil
+	}
+	y := make([]string, len(x))
+	copy(y, x)
+	return y
+}
+
+// Validate returns an error if v isn't a valid semantic version, which is possible when a Version is created or
+// modified without using Parse or New. The error is a *ParseError, as if String had been given to Parse, except that a
+// negative number in the version core is reported as ErrorNegativeNumber.
+func (v *Version) Validate() error {
+	input := v.String()
+
+	var offset int
+	for i := 0; i < 3; i += 1 {
+		if *v.coreField(i) < 0 {
+			return newParseError(input, offset, ComponentCore, ParseErrorNegativeNumber)
+		}
+		// skip the number and the separator after it
+		offset += len(v.coreNumber(i)) + 1
+	}
+
+	if len(v.Prerelease) != 0 {
+		var err error
+		if offset, err = validateIdentifiers(input, offset, v.Prerelease, ComponentPrerelease); err != nil {
+			return err
+		}
+	}
+
+	if len(v.Build) != 0 {
+		if _, err := validateIdentifiers(input, offset, v.Build, ComponentBuild); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateIdentifiers checks the prerelease or build identifiers that start at offset in input, and returns the offset
+// after the separator that follows them.
+func validateIdentifiers(input string, offset int, identifiers []string, component Component) (int, error) {
+	for _, identifier := range identifiers {
+		if identifier == "" {
+			code := ParseErrorEmptyPrereleaseIdentifier
+			if component == ComponentBuild {
+				code = ParseErrorEmptyBuildIdentifier
+			}
+			return 0, newParseError(input, offset, component, code)
+		}
+
+		for i, char := range identifier {
+			if !isAlphanumericIdentifier(char) {
+				return 0, newParseError(input, offset+i, component, ParseErrorUnrecognisedCharacter)
+			}
+		}
+
+		if len(identifier) > 1 && identifier[0] == '0' && isStringNumeric(identifier) {
+			return 0, newParseError(input, offset, component, ParseErrorLeadingZero)
+		}
+
+		offset += len(identifier) + 1
+	}
+	return offset, nil
+}
@@ -0,0 +1,111 @@
+package semver
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name                string
+		major, minor, patch int
+		prerelease, build   []string
+		want                string
+		wantErr             error
+		wantOffset          int
+	}{
+		{name: "Version core", major: 1, minor: 2, patch: 3, want: "1.2.3"},
+		{name: "Prerelease and build", major: 1, minor: 2, patch: 3, prerelease: msp("rc.1"), build: msp("b.01a"), want: "1.2.3-rc.1+b.01a"},
+		{name: "Empty slices", major: 1, prerelease: []string{}, build: []string{}, want: "1.0.0"},
+
+		{name: "Negative major", major: -1, wantErr: ErrorNegativeNumber, wantOffset: 0},
+		{name: "Negative patch", major: 10, patch: -2, wantErr: ErrorNegativeNumber, wantOffset: 5},
+		{name: "Leading zero in build", major: 1, build: []string{"01"}, wantErr: ErrorLeadingZero, wantOffset: 6},
+		{name: "Leading zero", major: 1, prerelease: []string{"a", "01"}, wantErr: ErrorLeadingZero, wantOffset: 8},
+		{name: "Empty prerelease identifier", major: 1, prerelease: []string{"a", ""}, wantErr: ErrorEmptyPrereleaseIdentifier, wantOffset: 8},
+		{name: "Empty build identifier", major: 1, prerelease: msp("rc"), build: []string{""}, wantErr: ErrorEmptyBuildIdentifier, wantOffset: 9},
+		{name: "Dot in identifier", major: 1, prerelease: []string{"rc.1"}, wantErr: ErrorUnrecognisedCharacter, wantOffset: 8},
+		{name: "Unrecognised character in build", major: 1, build: []string{"a_b"}, wantErr: ErrorUnrecognisedCharacter, wantOffset: 7},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := New(tt.major, tt.minor, tt.patch, tt.prerelease, tt.build)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("New() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				var pe *ParseError
+				if !errors.As(err, &pe) || pe.Offset != tt.wantOffset {
+					t.Errorf("New() error = %#v, want offset %d", err, tt.wantOffset)
+				}
+				return
+			}
+			if !reflect.DeepEqual(got, mkv(tt.want)) {
+				t.Errorf("New() = %#v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewCopiesIdentifiers(t *testing.T) {
+	prerelease := []string{"rc", "1"}
+	v := MustNew(1, 2, 3, prerelease, nil)
+	prerelease[1] = "2"
+	if v.String() != "1.2.3-rc.1" {
+		t.Errorf("New() = %v, which changed when its prerelease argument did", v)
+	}
+}
+
+func TestVersion_With(t *testing.T) {
+	v := mkv("1.2.3-rc.1+build.5")
+
+	tests := []struct {
+		name    string
+		with    func() (*Version, error)
+		want    string
+		wantErr error
+	}{
+		{name: "Prerelease", with: func() (*Version, error) { return v.WithPrerelease("beta", "2") }, want: "1.2.3-beta.2+build.5"},
+		{name: "No prerelease", with: func() (*Version, error) { return v.WithPrerelease() }, want: "1.2.3+build.5"},
+		{name: "Build", with: func() (*Version, error) { return v.WithBuild("sha", "abc") }, want: "1.2.3-rc.1+sha.abc"},
+		{name: "No build", with: func() (*Version, error) { return v.WithBuild() }, want: "1.2.3-rc.1"},
+		{name: "Invalid prerelease", with: func() (*Version, error) { return v.WithPrerelease("01") }, wantErr: ErrorLeadingZero},
+		{name: "Invalid build", with: func() (*Version, error) { return v.WithBuild("") }, wantErr: ErrorEmptyBuildIdentifier},
+		{name: "Large", with: func() (*Version, error) { return mkv("18446744073709551616.2.3").WithPrerelease("rc") }, want: "18446744073709551616.2.3-rc"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.with()
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("With() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && !reflect.DeepEqual(got, mkv(tt.want)) {
+				t.Errorf("With() = %#v, want %v", got, tt.want)
+			}
+			if v.String() != "1.2.3-rc.1+build.5" {
+				t.Errorf("With() modified the version to %v", v)
+			}
+		})
+	}
+}
+
+func TestVersion_Validate(t *testing.T) {
+	v := &Version{Major: 1, Prerelease: []string{"01", ""}}
+	err := v.Validate()
+
+	var pe *ParseError
+	if !errors.As(err, &pe) {
+		t.Fatalf("Validate() error = %#v, want a *ParseError", err)
+	}
+	want := &ParseError{Input: "1.0.0-01.", Offset: 6, Component: ComponentPrerelease, Code: ParseErrorLeadingZero}
+	if !reflect.DeepEqual(pe, want) {
+		t.Errorf("Validate() error = %#v, want %#v", pe, want)
+	}
+
+	if err := mkv("1.2.3-rc.1+build.01a").Validate(); err != nil {
+		t.Errorf("Validate() error = %v, want nil", err)
+	}
+}
@@ -2,7 +2,6 @@ package semver
 
 import (
 	"errors"
-	"fmt"
 	"strconv"
 )
 
@@ -23,10 +22,17 @@ var (
 	ErrorLeadingZero               = errors.New("semver: Parse: leading zeros are disallowed")
 	ErrorEmptyPrereleaseIdentifier = errors.New("semver: Parse: empty prerelease identifier")
 	ErrorEmptyBuildIdentifier      = errors.New("semver: Parse: empty build identifier")
+	ErrorUnrecognisedCharacter     = errors.New("semver: Parse: unrecognised character")
 )
 
+// Parse parses a version string. If it is not a valid semantic version, the error is a *ParseError. The returned
+// *Version is always allocated - use ParseCompact to parse versions without allocating.
 func Parse(in string) (*Version, error) {
 
+	if major, minor, patch, ok := parseSimple(in); ok {
+		return &Version{Major: major, Minor: minor, Patch: patch}, nil
+	}
+
 	type parseState uint8
 	const (
 		versionCore parseState = iota
@@ -55,14 +61,19 @@ func Parse(in string) (*Version, error) {
 	}
 
 	version := new(Version)
-	var buf []rune
+	// bufStart is the offset of the start of the number or identifier being parsed, which runs up to index
+	var bufStart int
 
 	for index < len(in) {
 		switch state {
 		case versionCore:
 
-			writeBufTo := func(x *int) { *x, _ = strconv.Atoi(string(buf)) }
-			errorUnknownCharacter := func() error { return fmt.Errorf("parse: unrecognised character '%s' in version core", string(peek(0))) }
+			errorAt := func(offset int, code ParseErrorCode) error {
+				return newParseError(in, offset, ComponentCore, code)
+			}
+			writeBufTo := func(component int) {
+				version.setCoreNumber(component, in[bufStart:index])
+			}
 
 			var component int
 			// TODO: these nested for loops could probably be removed somehow. At present, however, this is not-trivial
@@ -71,160 +82,182 @@ func Parse(in string) (*Version, error) {
 
 				if isDigit(peek(0)) {
 
-					if peek(0) == '0' && len(buf) == 0 && isDigit(peek(1)) {
-						return nil, ErrorLeadingZero
+					if peek(0) == '0' && index == bufStart && isDigit(peek(1)) {
+						return nil, errorAt(index, ParseErrorLeadingZero)
 					}
 
-					buf = append(buf, consume())
+					consume()
 				} else if peek(0) == 0 {
 					// end of input, nothing more to parse
 
-					if component != 2 {
-						return nil, ErrorIncompleteVersionCore
+					if component != 2 || index == bufStart {
+						return nil, errorAt(index, ParseErrorIncompleteVersionCore)
 					}
 
-					writeBufTo(&version.Patch)
-					buf = nil
+					writeBufTo(2)
+					bufStart = index
 
 					break
 				} else if peek(0) == '.' {
 
-					consume()
+					if index == bufStart {
+						return nil, errorAt(index, ParseErrorUnrecognisedCharacter)
+					}
 
-					if component == 0 { // major number
-						writeBufTo(&version.Major)
-					} else if component == 1 { // minor number
-						writeBufTo(&version.Minor)
+					if component == 2 {
+						return nil, errorAt(index, ParseErrorUnrecognisedCharacter)
 					}
+					writeBufTo(component) // major or minor number
+
+					consume()
 
 					component += 1
-					buf = nil
+					bufStart = index
 
-				} else if len(buf) != 0 && peek(-1) != '.' {
+				} else if index != bufStart && peek(-1) != '.' {
 					if peek(0) == '-' {
 						// moving on to prerelease section
 
 						if component != 2 {
-							return nil, ErrorIncompleteVersionCore
+							return nil, errorAt(index, ParseErrorIncompleteVersionCore)
 						} else if peek(1) == 0 {
-							return nil, ErrorEmptyPrereleaseIdentifier
+							return nil, newParseError(in, index+1, ComponentPrerelease, ParseErrorEmptyPrereleaseIdentifier)
 						}
 
-						writeBufTo(&version.Patch)
-						buf = nil
+						writeBufTo(2)
 						consume()
+						bufStart = index
 						state = prerelease
 						break
 					} else if peek(0) == '+' {
 						// moving on to build section
 
 						if component != 2 {
-							return nil, ErrorIncompleteVersionCore
+							return nil, errorAt(index, ParseErrorIncompleteVersionCore)
 						} else if peek(1) == 0 {
-							return nil, ErrorEmptyBuildIdentifier
+							return nil, newParseError(in, index+1, ComponentBuild, ParseErrorEmptyBuildIdentifier)
 						}
 
-						writeBufTo(&version.Patch)
-						buf = nil
+						writeBufTo(2)
 						consume()
+						bufStart = index
 						state = build
 						break
 					} else {
-						return nil, errorUnknownCharacter()
+						return nil, errorAt(index, ParseErrorUnrecognisedCharacter)
 					}
 				} else {
-					return nil, errorUnknownCharacter()
+					return nil, errorAt(index, ParseErrorUnrecognisedCharacter)
 				}
 			}
 
 		case prerelease:
 			// dot separated prerelease identifiers, runs until end or '+'
 
-			writeBuf := func() {
-				version.Prerelease = append(version.Prerelease, string(buf))
+			errorAt := func(offset int, code ParseErrorCode) error {
+				return newParseError(in, offset, ComponentPrerelease, code)
+			}
+
+			writeBuf := func() error {
+				x := in[bufStart:index]
+				if isStringNumeric(x) && x[0] == byte('0') && len(x) > 1 { // leading zeros on numeric ids disallowed
+					return errorAt(bufStart, ParseErrorLeadingZero)
+				}
+				version.Prerelease = append(version.Prerelease, x)
+				return nil
 			}
 
 			for {
 				if isAlphanumericIdentifier(peek(0)) || isDigit(peek(0)) {
-					buf = append(buf, consume())
+					consume()
 				} else if peek(0) == '.' {
-					if peek(1) == '.' || peek(1) == '+' {
-						return nil, ErrorEmptyPrereleaseIdentifier
+					if index == bufStart {
+						return nil, errorAt(index, ParseErrorEmptyPrereleaseIdentifier)
+					}
+					if err := writeBuf(); err != nil {
+						return nil, err
 					}
-					writeBuf()
 					consume()
-					buf = nil
+					bufStart = index
 				} else if peek(0) == 0 {
 
-					if len(buf) == 0 || buf[len(buf)-1] == '.' {
-						return nil, ErrorEmptyPrereleaseIdentifier
+					if index == bufStart {
+						return nil, errorAt(index, ParseErrorEmptyPrereleaseIdentifier)
 					}
 
 					// end
-					writeBuf()
-					buf = nil
+					if err := writeBuf(); err != nil {
+						return nil, err
+					}
+					bufStart = index
 					break
 				} else if peek(0) == '+' {
+					if index == bufStart {
+						return nil, errorAt(index, ParseErrorEmptyPrereleaseIdentifier)
+					} else if peek(1) == 0 {
+						return nil, newParseError(in, index+1, ComponentBuild, ParseErrorEmptyBuildIdentifier)
+					}
+					if err := writeBuf(); err != nil {
+						return nil, err
+					}
 					consume()
-					writeBuf()
-					buf = nil
+					bufStart = index
 					state = build
 					break
 				} else {
-					return nil, fmt.Errorf("parse: unrecognised character '%s' in pre-release", string(peek(0)))
-				}
-			}
-
-			for _, x := range version.Prerelease {
-				if isStringNumeric(x) && x[0] == byte('0') && len(x) > 1 { // leading zeros on numeric ids disallowed
-					return nil, ErrorLeadingZero
+					return nil, errorAt(index, ParseErrorUnrecognisedCharacter)
 				}
 			}
 
 		case build:
 			// dot separated build identifiers, runs until end
 
-			writeBuf := func() {
-				version.Build = append(version.Build, string(buf))
+			errorAt := func(offset int, code ParseErrorCode) error {
+				return newParseError(in, offset, ComponentBuild, code)
+			}
+
+			writeBuf := func() error {
+				x := in[bufStart:index]
+				if isStringNumeric(x) && x[0] == byte('0') && len(x) > 1 { // leading zeros on numeric ids disallowed
+					return errorAt(bufStart, ParseErrorLeadingZero)
+				}
+				version.Build = append(version.Build, x)
+				return nil
 			}
 
 			for {
 
 				if isAlphanumericIdentifier(peek(0)) || isDigit(peek(0)) {
-					buf = append(buf, consume())
+					consume()
 				} else if peek(0) == '.' {
-					if peek(1) == '.' {
-						return nil, ErrorEmptyBuildIdentifier
+					if index == bufStart {
+						return nil, errorAt(index, ParseErrorEmptyBuildIdentifier)
+					}
+					if err := writeBuf(); err != nil {
+						return nil, err
 					}
-					writeBuf()
 					consume()
-					buf = nil
+					bufStart = index
 				} else if peek(0) == 0 {
 
-					if len(buf) == 0 || buf[len(buf)-1] == '.' {
-						return nil, ErrorEmptyBuildIdentifier
+					if index == bufStart {
+						return nil, errorAt(index, ParseErrorEmptyBuildIdentifier)
 					}
 
 					// end
-					writeBuf()
-					buf = nil
+					if err := writeBuf(); err != nil {
+						return nil, err
+					}
+					bufStart = index
 					break
 				} else {
-					return nil, fmt.Errorf("parse: unrecognised character '%s' in build", string(peek(0)))
-				}
-			}
-
-			for _, x := range version.Build {
-				if isStringNumeric(x) && x[0] == byte('0') && len(x) > 1 { // leading zeros on numeric ids disallowed
-					return nil, ErrorLeadingZero
+					return nil, errorAt(index, ParseErrorUnrecognisedCharacter)
 				}
 			}
 
 		}
 	}
 
-	version.Stable = version.Major != 0 && len(version.Prerelease) == 0
-
 	return version, nil
 }
 
@@ -240,10 +273,75 @@ func ParseMultiple(rawVersions []string) (Slice, error) {
 	return x, nil
 }
 
+// ParseBytes is the same as Parse, but takes a byte slice. Versions that only have a version core, eg `1.2.3`, are
+// parsed without allocating a string, but the returned *Version is still allocated. Use ParseCompactBytes to parse
+// them without allocating at all.
+func ParseBytes(in []byte) (*Version, error) {
+	// the string doesn't escape, so the compiler copies it to the stack rather than allocating it as long as it's
+	// short, which any version that parseSimple accepts almost always is. This only saves allocating the string.
+	if major, minor, patch, ok := parseSimple(string(in)); ok {
+		return &Version{Major: major, Minor: minor, Patch: patch}, nil
+	}
+	return Parse(string(in))
+}
+
+// maxSimpleDigits is the longest number that parseSimple will parse, which is the most digits that are guaranteed to
+// fit in an int.
+const maxSimpleDigits = strconv.IntSize / 32 * 9
+
+// parseSimple parses a version that only has a version core, without allocating. If ok is false, the version is
+// either something more complicated or invalid and needs to be given to Parse.
+func parseSimple(in string) (major, minor, patch int, ok bool) {
+	var core [3]int
+	var component, start int
+	for i := 0; i <= len(in); i += 1 {
+		if i < len(in) && isDigit(rune(in[i])) {
+			core[component] = core[component]*10 + int(in[i]-'0')
+			continue
+		}
+
+		if i == start || i-start > maxSimpleDigits || (in[start] == '0' && i-start > 1) {
+			return 0, 0, 0, false
+		}
+
+		if i == len(in) {
+			break
+		} else if in[i] != '.' || component == 2 {
+			return 0, 0, 0, false
+		}
+
+		component += 1
+		start = i + 1
+	}
+
+	return core[0], core[1], core[2], component == 2
+}
+
+// ParseAll parses every version in rawVersions. Unlike ParseMultiple, it doesn't stop at the first version that can't
+// be parsed - it returns every version that could be, in their original order, and a *ParseMultipleError that lists
+// the ones that couldn't. If all of them were parsed, the error is nil.
+func ParseAll(rawVersions []string) (Slice, error) {
+	var x Slice
+	var failures []ParseFailure
+	for i, rawVersion := range rawVersions {
+		parsedVersion, err := Parse(rawVersion)
+		if err != nil {
+			failures = append(failures, ParseFailure{Index: i, Input: rawVersion, Err: err})
+			continue
+		}
+		x = append(x, parsedVersion)
+	}
+
+	if len(failures) != 0 {
+		return x, &ParseMultipleError{Total: len(rawVersions), Failures: failures}
+	}
+	return x, nil
+}
+
 func MustParse(in string) *Version {
 	v, err := Parse(in)
 	if err != nil {
 		panic(err)
 	}
 	return v
-}
\ No newline at end of file
+}
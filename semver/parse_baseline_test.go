@@ -0,0 +1,253 @@
+package semver
+
+import "strconv"
+
+// parseBaseline is Parse as it was before ParseBytes, parseSimple and Compact were added, when it collected each
+// number and identifier into a rune buffer. It is only kept so that the benchmarks can show how much faster Parse has
+// become, and shouldn't be used for anything else.
+func parseBaseline(in string) (*Version, error) {
+
+	type parseState uint8
+	const (
+		versionCore parseState = iota
+		prerelease
+		build
+	)
+
+	var state parseState
+	var index int
+
+	peek := func(offset int) rune {
+		n := index + offset
+		if n >= len(in) || n < 0 {
+			return 0
+		}
+		return rune(in[n])
+	}
+
+	consume := func() rune {
+		if index >= len(in) {
+			return 0
+		}
+		x := in[index]
+		index += 1
+		return rune(x)
+	}
+
+	version := new(Version)
+	var buf []rune
+
+	for index < len(in) {
+		switch state {
+		case versionCore:
+
+			errorAt := func(offset int, code ParseErrorCode) error {
+				return newParseError(in, offset, ComponentCore, code)
+			}
+			writeBufTo := func(x *int) error {
+				n, err := strconv.Atoi(string(buf))
+				if err != nil {
+					return err
+				}
+				*x = n
+				return nil
+			}
+
+			var component int
+			// TODO: these nested for loops could probably be removed somehow. At present, however, this is not-trivial
+			//  because of the requirement in some cases to run until `peek(0)` returns 0.
+			for {
+
+				if isDigit(peek(0)) {
+
+					if peek(0) == '0' && len(buf) == 0 && isDigit(peek(1)) {
+						return nil, errorAt(index, ParseErrorLeadingZero)
+					}
+
+					buf = append(buf, consume())
+				} else if peek(0) == 0 {
+					// end of input, nothing more to parse
+
+					if component != 2 || len(buf) == 0 {
+						return nil, errorAt(index, ParseErrorIncompleteVersionCore)
+					}
+
+					if err := writeBufTo(&version.Patch); err != nil {
+						return nil, err
+					}
+					buf = nil
+
+					break
+				} else if peek(0) == '.' {
+
+					if len(buf) == 0 {
+						return nil, errorAt(index, ParseErrorUnrecognisedCharacter)
+					}
+
+					var err error
+					if component == 0 { // major number
+						err = writeBufTo(&version.Major)
+					} else if component == 1 { // minor number
+						err = writeBufTo(&version.Minor)
+					} else {
+						err = errorAt(index, ParseErrorUnrecognisedCharacter)
+					}
+					if err != nil {
+						return nil, err
+					}
+
+					consume()
+
+					component += 1
+					buf = nil
+
+				} else if len(buf) != 0 && peek(-1) != '.' {
+					if peek(0) == '-' {
+						// moving on to prerelease section
+
+						if component != 2 {
+							return nil, errorAt(index, ParseErrorIncompleteVersionCore)
+						} else if peek(1) == 0 {
+							return nil, newParseError(in, index+1, ComponentPrerelease, ParseErrorEmptyPrereleaseIdentifier)
+						}
+
+						if err := writeBufTo(&version.Patch); err != nil {
+							return nil, err
+						}
+						buf = nil
+						consume()
+						state = prerelease
+						break
+					} else if peek(0) == '+' {
+						// moving on to build section
+
+						if component != 2 {
+							return nil, errorAt(index, ParseErrorIncompleteVersionCore)
+						} else if peek(1) == 0 {
+							return nil, newParseError(in, index+1, ComponentBuild, ParseErrorEmptyBuildIdentifier)
+						}
+
+						if err := writeBufTo(&version.Patch); err != nil {
+							return nil, err
+						}
+						buf = nil
+						consume()
+						state = build
+						break
+					} else {
+						return nil, errorAt(index, ParseErrorUnrecognisedCharacter)
+					}
+				} else {
+					return nil, errorAt(index, ParseErrorUnrecognisedCharacter)
+				}
+			}
+
+		case prerelease:
+			// dot separated prerelease identifiers, runs until end or '+'
+
+			errorAt := func(offset int, code ParseErrorCode) error {
+				return newParseError(in, offset, ComponentPrerelease, code)
+			}
+
+			writeBuf := func() error {
+				x := string(buf)
+				if isStringNumeric(x) && x[0] == byte('0') && len(x) > 1 { // leading zeros on numeric ids disallowed
+					return errorAt(index-len(buf), ParseErrorLeadingZero)
+				}
+				version.Prerelease = append(version.Prerelease, x)
+				return nil
+			}
+
+			for {
+				if isAlphanumericIdentifier(peek(0)) || isDigit(peek(0)) {
+					buf = append(buf, consume())
+				} else if peek(0) == '.' {
+					if len(buf) == 0 {
+						return nil, errorAt(index, ParseErrorEmptyPrereleaseIdentifier)
+					}
+					if err := writeBuf(); err != nil {
+						return nil, err
+					}
+					consume()
+					buf = nil
+				} else if peek(0) == 0 {
+
+					if len(buf) == 0 {
+						return nil, errorAt(index, ParseErrorEmptyPrereleaseIdentifier)
+					}
+
+					// end
+					if err := writeBuf(); err != nil {
+						return nil, err
+					}
+					buf = nil
+					break
+				} else if peek(0) == '+' {
+					if len(buf) == 0 {
+						return nil, errorAt(index, ParseErrorEmptyPrereleaseIdentifier)
+					} else if peek(1) == 0 {
+						return nil, newParseError(in, index+1, ComponentBuild, ParseErrorEmptyBuildIdentifier)
+					}
+					if err := writeBuf(); err != nil {
+						return nil, err
+					}
+					consume()
+					buf = nil
+					state = build
+					break
+				} else {
+					return nil, errorAt(index, ParseErrorUnrecognisedCharacter)
+				}
+			}
+
+		case build:
+			// dot separated build identifiers, runs until end
+
+			errorAt := func(offset int, code ParseErrorCode) error {
+				return newParseError(in, offset, ComponentBuild, code)
+			}
+
+			writeBuf := func() error {
+				x := string(buf)
+				if isStringNumeric(x) && x[0] == byte('0') && len(x) > 1 { // leading zeros on numeric ids disallowed
+					return errorAt(index-len(buf), ParseErrorLeadingZero)
+				}
+				version.Build = append(version.Build, x)
+				return nil
+			}
+
+			for {
+
+				if isAlphanumericIdentifier(peek(0)) || isDigit(peek(0)) {
+					buf = append(buf, consume())
+				} else if peek(0) == '.' {
+					if len(buf) == 0 {
+						return nil, errorAt(index, ParseErrorEmptyBuildIdentifier)
+					}
+					if err := writeBuf(); err != nil {
+						return nil, err
+					}
+					consume()
+					buf = nil
+				} else if peek(0) == 0 {
+
+					if len(buf) == 0 {
+						return nil, errorAt(index, ParseErrorEmptyBuildIdentifier)
+					}
+
+					// end
+					if err := writeBuf(); err != nil {
+						return nil, err
+					}
+					buf = nil
+					break
+				} else {
+					return nil, errorAt(index, ParseErrorUnrecognisedCharacter)
+				}
+			}
+
+		}
+	}
+
+	return version, nil
+}
@@ -2,6 +2,7 @@ package semver
 
 import (
 	"reflect"
+	"strconv"
 	"strings"
 	"testing"
 )
@@ -37,9 +38,9 @@ func msp(i string) []string {
 func TestParseVersionCore(t *testing.T) {
 	parseTests{
 		// must have a major, minor and patch build version
-		{name: "Valid version core", args: "1.0.0", want: &Version{Major: 1, Minor: 0, Patch: 0, Stable: true}},
-		{name: "Valid version core", args: "1.2.3", want: &Version{Major: 1, Minor: 2, Patch: 3, Stable: true}},
-		{name: "Valid version core", args: "65535.65534.65533", want: &Version{Major: 65535, Minor: 65534, Patch: 65533, Stable: true}},
+		{name: "Valid version core", args: "1.0.0", want: &Version{Major: 1, Minor: 0, Patch: 0}},
+		{name: "Valid version core", args: "1.2.3", want: &Version{Major: 1, Minor: 2, Patch: 3}},
+		{name: "Valid version core", args: "65535.65534.65533", want: &Version{Major: 65535, Minor: 65534, Patch: 65533}},
 		{name: "Incomplete version core", args: "1", wantErr: true},
 		{name: "Incomplete version core", args: "1.1", wantErr: true},
 
@@ -59,24 +60,29 @@ func TestParseVersionCore(t *testing.T) {
 		// Incomplete
 		{name: "Incomplete version core", args: "1.0-banana", wantErr: true},
 		{name: "Incomplete version core", args: "1.0+banana", wantErr: true},
+
+		// can be any size
+		{name: "Major version too large for an int", args: "18446744073709551616.0.0", want: &Version{Major: maxInt, large: "18446744073709551616.."}},
+		{name: "Patch version too large for an int", args: "1.0.99999999999999999999-rc.1", want: &Version{Major: 1, Patch: maxInt, Prerelease: msp("rc.1"), large: "..99999999999999999999"}},
+		{name: "Largest int", args: "1.0." + strconv.Itoa(maxInt), want: &Version{Major: 1, Patch: maxInt}},
 	}.Run(t)
 }
 
 func TestParsePrerelease(t *testing.T) {
 	parseTests{
 		// contains one or more dot-separated ids with distinct numeric and mixed ids
-		{name: "Valid text", args: "1.0.0-alpha", want: &Version{Major: 1, Minor: 0, Patch: 0, Stable: false, Prerelease: msp("alpha")}},
-		{name: "Valid text", args: "1.2.3-test", want: &Version{Major: 1, Minor: 2, Patch: 3, Stable: false, Prerelease: msp("test")}},
-		{name: "Valid digits", args: "1.2.3-321", want: &Version{Major: 1, Minor: 2, Patch: 3, Stable: false, Prerelease: msp("321")}},
-		{name: "Valid digit", args: "1.0.0-0", want: &Version{Major: 1, Minor: 0, Patch: 0, Stable: false, Prerelease: msp("0")}},
-		{name: "Valid multipart", args: "1.2.3-test.1", want: &Version{Major: 1, Minor: 2, Patch: 3, Stable: false, Prerelease: msp("test.1")}},
-		{name: "Valid multipart", args: "1.2.3-1.test", want: &Version{Major: 1, Minor: 2, Patch: 3, Stable: false, Prerelease: msp("1.test")}},
-		{name: "Valid multipart", args: "1.2.3-test.123456", want: &Version{Major: 1, Minor: 2, Patch: 3, Stable: false, Prerelease: msp("test.123456")}},
-		{name: "Valid multipart", args: "1.2.3-123456.test", want: &Version{Major: 1, Minor: 2, Patch: 3, Stable: false, Prerelease: msp("123456.test")}},
-		{name: "Long prerelease", args: "1.2.3-1.a.22.bb.333.ccc.4444.dddd.55555.fffff", want: &Version{Major: 1, Minor: 2, Patch: 3, Stable: false, Prerelease: msp("1.a.22.bb.333.ccc.4444.dddd.55555.fffff")}},
+		{name: "Valid text", args: "1.0.0-alpha", want: &Version{Major: 1, Minor: 0, Patch: 0, Prerelease: msp("alpha")}},
+		{name: "Valid text", args: "1.2.3-test", want: &Version{Major: 1, Minor: 2, Patch: 3, Prerelease: msp("test")}},
+		{name: "Valid digits", args: "1.2.3-321", want: &Version{Major: 1, Minor: 2, Patch: 3, Prerelease: msp("321")}},
+		{name: "Valid digit", args: "1.0.0-0", want: &Version{Major: 1, Minor: 0, Patch: 0, Prerelease: msp("0")}},
+		{name: "Valid multipart", args: "1.2.3-test.1", want: &Version{Major: 1, Minor: 2, Patch: 3, Prerelease: msp("test.1")}},
+		{name: "Valid multipart", args: "1.2.3-1.test", want: &Version{Major: 1, Minor: 2, Patch: 3, Prerelease: msp("1.test")}},
+		{name: "Valid multipart", args: "1.2.3-test.123456", want: &Version{Major: 1, Minor: 2, Patch: 3, Prerelease: msp("test.123456")}},
+		{name: "Valid multipart", args: "1.2.3-123456.test", want: &Version{Major: 1, Minor: 2, Patch: 3, Prerelease: msp("123456.test")}},
+		{name: "Long prerelease", args: "1.2.3-1.a.22.bb.333.ccc.4444.dddd.55555.fffff", want: &Version{Major: 1, Minor: 2, Patch: 3, Prerelease: msp("1.a.22.bb.333.ccc.4444.dddd.55555.fffff")}},
 
 		// contain only alphanumerics and hyphen
-		{name: "Valid alphanumerics and hyphen", args: "1.2.3-test-1-2-3-CAP", want: &Version{Major: 1, Minor: 2, Patch: 3, Stable: false, Prerelease: msp("test-1-2-3-CAP")}},
+		{name: "Valid alphanumerics and hyphen", args: "1.2.3-test-1-2-3-CAP", want: &Version{Major: 1, Minor: 2, Patch: 3, Prerelease: msp("test-1-2-3-CAP")}},
 		{name: "Invalid with hash symbol", args: "1.2.3-test#1", wantErr: true},
 		{name: "Invalid with copyright symbol", args: "1.2.3-test.©2015", wantErr: true},
 		{name: "Invalid with cyrillic", args: "1.2.3-ћирилица-1", wantErr: true},
@@ -91,26 +97,28 @@ func TestParsePrerelease(t *testing.T) {
 		// numeric ids must not have leading 0
 		{name: "Numeric with leading zero", args: "1.2.3-01", wantErr: true},
 		{name: "Numeric with leading zero", args: "1.2.3-test.0023", wantErr: true},
-		{name: "Alphanumeric with leading zero", args: "1.2.3-test.01a", want: &Version{Major: 1, Minor: 2, Patch: 3, Stable: false, Prerelease: msp("test.01a")}},
-		{name: "Alphanumeric with leading zero", args: "1.2.3-test.01-s", want: &Version{Major: 1, Minor: 2, Patch: 3, Stable: false, Prerelease: msp("test.01-s")}},
+		{name: "Numeric too large for an int", args: "1.2.3-99999999999999999999", want: &Version{Major: 1, Minor: 2, Patch: 3, Prerelease: msp("99999999999999999999")}},
+		{name: "Numeric too large for an int with leading zero", args: "1.2.3-099999999999999999999", wantErr: true},
+		{name: "Alphanumeric with leading zero", args: "1.2.3-test.01a", want: &Version{Major: 1, Minor: 2, Patch: 3, Prerelease: msp("test.01a")}},
+		{name: "Alphanumeric with leading zero", args: "1.2.3-test.01-s", want: &Version{Major: 1, Minor: 2, Patch: 3, Prerelease: msp("test.01-s")}},
 	}.Run(t)
 }
 
 func TestParseBuild(t *testing.T) {
 	parseTests{
 		// contains one or more dot separated ids
-		{name: "Valid text", args: "1.0.0+test", want: &Version{Major: 1, Minor: 0, Patch: 0, Stable: true, Build: msp("test")}},
-		{name: "Valid text", args: "1.2.3+test", want: &Version{Major: 1, Minor: 2, Patch: 3, Stable: true, Build: msp("test")}},
-		{name: "Valid digits", args: "1.2.3+321", want: &Version{Major: 1, Minor: 2, Patch: 3, Stable: true, Build: msp("321")}},
-		{name: "Valid digit", args: "1.0.0+0", want: &Version{Major: 1, Minor: 0, Patch: 0, Stable: true, Build: msp("0")}},
-		{name: "Valid multipart", args: "1.2.3+test.1", want: &Version{Major: 1, Minor: 2, Patch: 3, Stable: true, Build: msp("test.1")}},
-		{name: "Valid multipart", args: "1.2.3+1.test", want: &Version{Major: 1, Minor: 2, Patch: 3, Stable: true, Build: msp("1.test")}},
-		{name: "Valid multipart", args: "1.2.3+test.123456", want: &Version{Major: 1, Minor: 2, Patch: 3, Stable: true, Build: msp("test.123456")}},
-		{name: "Valid multipart", args: "1.2.3+123456.test", want: &Version{Major: 1, Minor: 2, Patch: 3, Stable: true, Build: msp("123456.test")}},
-		{name: "Long build", args: "1.2.3+1.a.22.bb.333.ccc.4444.dddd.55555.fffff", want: &Version{Major: 1, Minor: 2, Patch: 3, Stable: true, Build: msp("1.a.22.bb.333.ccc.4444.dddd.55555.fffff")}},
+		{name: "Valid text", args: "1.0.0+test", want: &Version{Major: 1, Minor: 0, Patch: 0, Build: msp("test")}},
+		{name: "Valid text", args: "1.2.3+test", want: &Version{Major: 1, Minor: 2, Patch: 3, Build: msp("test")}},
+		{name: "Valid digits", args: "1.2.3+321", want: &Version{Major: 1, Minor: 2, Patch: 3, Build: msp("321")}},
+		{name: "Valid digit", args: "1.0.0+0", want: &Version{Major: 1, Minor: 0, Patch: 0, Build: msp("0")}},
+		{name: "Valid multipart", args: "1.2.3+test.1", want: &Version{Major: 1, Minor: 2, Patch: 3, Build: msp("test.1")}},
+		{name: "Valid multipart", args: "1.2.3+1.test", want: &Version{Major: 1, Minor: 2, Patch: 3, Build: msp("1.test")}},
+		{name: "Valid multipart", args: "1.2.3+test.123456", want: &Version{Major: 1, Minor: 2, Patch: 3, Build: msp("test.123456")}},
+		{name: "Valid multipart", args: "1.2.3+123456.test", want: &Version{Major: 1, Minor: 2, Patch: 3, Build: msp("123456.test")}},
+		{name: "Long build", args: "1.2.3+1.a.22.bb.333.ccc.4444.dddd.55555.fffff", want: &Version{Major: 1, Minor: 2, Patch: 3, Build: msp("1.a.22.bb.333.ccc.4444.dddd.55555.fffff")}},
 
 		// contain only alphanumerics and hyphen
-		{name: "Valid alphanumerics and hyphen", args: "1.2.3+test-1-2-3-CAP", want: &Version{Major: 1, Minor: 2, Patch: 3, Stable: true, Build: msp("test-1-2-3-CAP")}},
+		{name: "Valid alphanumerics and hyphen", args: "1.2.3+test-1-2-3-CAP", want: &Version{Major: 1, Minor: 2, Patch: 3, Build: msp("test-1-2-3-CAP")}},
 		{name: "Invalid with hash symbol", args: "1.2.3+test#1", wantErr: true},
 		{name: "Invalid with copyright symbol", args: "1.2.3+test.©2015", wantErr: true},
 		{name: "Invalid with cyrillic", args: "1.2.3+ћирилица-1", wantErr: true},
@@ -125,15 +133,15 @@ func TestParseBuild(t *testing.T) {
 		// numeric ids must not have leading 0
 		{name: "Numeric with leading zero", args: "1.2.3+01", wantErr: true},
 		{name: "Numeric with leading zero", args: "1.2.3+test.0023", wantErr: true},
-		{name: "Alphanumeric with leading zero", args: "1.2.3+test.01a", want: &Version{Major: 1, Minor: 2, Patch: 3, Stable: true, Build: msp("test.01a")}},
-		{name: "Alphanumeric with leading zero", args: "1.2.3+test.01-s", want: &Version{Major: 1, Minor: 2, Patch: 3, Stable: true, Build: msp("test.01-s")}},
+		{name: "Alphanumeric with leading zero", args: "1.2.3+test.01a", want: &Version{Major: 1, Minor: 2, Patch: 3, Build: msp("test.01a")}},
+		{name: "Alphanumeric with leading zero", args: "1.2.3+test.01-s", want: &Version{Major: 1, Minor: 2, Patch: 3, Build: msp("test.01-s")}},
 	}.Run(t)
 }
 
 func TestParseSequence(t *testing.T) {
 	parseTests{
-		{name: "Prerelease and build", args: "1.2.3-r4+b5", want: &Version{Major: 1, Minor: 2, Patch: 3, Stable: false, Build: msp("b5"), Prerelease: msp("r4")}},
-		{name: "Build only", args: "1.2.3+b4-r5", want: &Version{Major: 1, Minor: 2, Patch: 3, Stable: true, Build: msp("b4-r5")}},
+		{name: "Prerelease and build", args: "1.2.3-r4+b5", want: &Version{Major: 1, Minor: 2, Patch: 3, Build: msp("b5"), Prerelease: msp("r4")}},
+		{name: "Build only", args: "1.2.3+b4-r5", want: &Version{Major: 1, Minor: 2, Patch: 3, Build: msp("b4-r5")}},
 	}.Run(t)
 }
 
@@ -141,9 +149,80 @@ func TestParseEdgeCases(t *testing.T) {
 	parseTests{
 		{name: "Empty prerelease ID then build", args: "1.2.3-r4.+b5", wantErr: true},
 		{name: "Prerelease ID then build with empty ID", args: "1.2.3-r4+b5.", wantErr: true},
+		{name: "Prerelease ID then empty build", args: "1.2.3-r4+", wantErr: true},
+		{name: "Empty first prerelease ID", args: "1.2.3-.r4", wantErr: true},
+		{name: "Empty minor version", args: "1..3", wantErr: true},
+		{name: "Empty patch version", args: "1.2.", wantErr: true},
+		{name: "Empty major version", args: ".2.3", wantErr: true},
 
-		{name: "Valid with prerelease and build", args: "1.2.3-alpha+build.314", want: &Version{Major: 1, Minor: 2, Patch: 3, Stable: false, Build: msp("build.314"), Prerelease: msp("alpha")}},
+		{name: "Valid with prerelease and build", args: "1.2.3-alpha+build.314", want: &Version{Major: 1, Minor: 2, Patch: 3, Build: msp("build.314"), Prerelease: msp("alpha")}},
 
-		{name: "Check Stable flag", args: "0.2.3", want: &Version{Major: 0, Minor: 2, Patch: 3, Stable: false}},
+		{name: "Major version zero", args: "0.2.3", want: &Version{Major: 0, Minor: 2, Patch: 3}},
 	}.Run(t)
 }
+
+func TestParseBytes(t *testing.T) {
+	for _, x := range []string{"1.2.3", "0.0.0", "1.2.3-rc.1+build.5"} {
+		got, err := ParseBytes([]byte(x))
+		if err != nil {
+			t.Errorf("ParseBytes(%s) error = %v", x, err)
+		} else if want := mkv(x); !reflect.DeepEqual(got, want) {
+			t.Errorf("ParseBytes(%s) = %#v, want %#v", x, got, want)
+		}
+	}
+
+	for _, x := range []string{"", "1.2", "1.02.3", "1.2.3.", "1.2.3-"} {
+		if _, err := ParseBytes([]byte(x)); err == nil && x != "" {
+			t.Errorf("ParseBytes(%s) error = <nil>, want error", x)
+		}
+	}
+}
+
+func TestParseSimple(t *testing.T) {
+	// the fast path must only accept versions that Parse would, and must give the same result
+	for _, x := range []string{"1.2.3", "0.0.0", "10.20.30", "999999999.999999999.999999999", "1.2", "1.2.3.4", ".1.2", "1..2", "1.2.",
+		"01.2.3", "1.02.3", "1.2.03", "1.2.3-rc.1", "1.2.3+b", "1.2.x", " 1.2.3", "99999999999999999999.0.0", ""} {
+		major, minor, patch, ok := parseSimple(x)
+		v, err := Parse(x)
+		if ok && (err != nil || v.Major != major || v.Minor != minor || v.Patch != patch) {
+			t.Errorf("parseSimple(%s) = %d.%d.%d, want Parse() = %v, %v", x, major, minor, patch, v, err)
+		}
+	}
+}
+
+func TestParseBytes_Allocs(t *testing.T) {
+	// only the *Version should be allocated, not a string
+	x := []byte("12.345.6789")
+	if n := testing.AllocsPerRun(100, func() { ParseBytes(x) }); n != 1 {
+		t.Errorf("ParseBytes() allocations = %v, want 1", n)
+	}
+}
+
+// benchmarkParsers are the ways of parsing a version, including parseBaseline so that the others can be compared to
+// how Parse used to be.
+var benchmarkParsers = []struct {
+	name  string
+	parse func(x string, xb []byte) error
+}{
+	{"Baseline", func(x string, _ []byte) error { _, err := parseBaseline(x); return err }},
+	{"Parse", func(x string, _ []byte) error { _, err := Parse(x); return err }},
+	{"ParseBytes", func(_ string, xb []byte) error { _, err := ParseBytes(xb); return err }},
+	{"ParseCompact", func(x string, _ []byte) error { _, err := ParseCompact(x); return err }},
+	{"ParseCompactBytes", func(_ string, xb []byte) error { _, err := ParseCompactBytes(xb); return err }},
+}
+
+func BenchmarkParse(b *testing.B) {
+	for _, x := range []string{"12.345.6789", "1.2.3-rc.1+build.5"} {
+		xb := []byte(x)
+		for _, p := range benchmarkParsers {
+			b.Run(x+"/"+p.name, func(b *testing.B) {
+				b.ReportAllocs()
+				for i := 0; i < b.N; i += 1 {
+					if err := p.parse(x, xb); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
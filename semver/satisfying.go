@@ -0,0 +1,100 @@
+package semver
+
+import "sort"
+
+// MaxSatisfying returns the highest version in options that satisfies filter, or nil if none of them do. Unlike
+// Filter, options is not modified.
+func MaxSatisfying(filter string, options Slice) (*Version, error) {
+	constraint, err := parseFilter(filter, ConstraintOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return constraint.MaxSatisfying(options), nil
+}
+
+// MinSatisfying returns the lowest version in options that satisfies filter, or nil if none of them do. Unlike
+// Filter, options is not modified.
+func MinSatisfying(filter string, options Slice) (*Version, error) {
+	constraint, err := parseFilter(filter, ConstraintOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return constraint.MinSatisfying(options), nil
+}
+
+// MinVersion returns the lowest possible version that satisfies filter, or nil if no version can satisfy it.
+func MinVersion(filter string) (*Version, error) {
+	constraint, err := parseFilter(filter, ConstraintOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return constraint.MinVersion(), nil
+}
+
+// MaxSatisfying returns the highest version in options that satisfies the constraint, or nil if none of them do.
+func (c *Constraint) MaxSatisfying(options Slice) *Version {
+	var max *Version
+	for _, x := range options {
+		if (max == nil || x.CompareTo(max) == 1) && c.Check(x) {
+			max = x
+		}
+	}
+	return max
+}
+
+// MinSatisfying returns the lowest version in options that satisfies the constraint, or nil if none of them do.
+func (c *Constraint) MinSatisfying(options Slice) *Version {
+	var min *Version
+	for _, x := range options {
+		if (min == nil || x.CompareTo(min) == -1) && c.Check(x) {
+			min = x
+		}
+	}
+	return min
+}
+
+// MinVersion returns the lowest possible version that satisfies the constraint, or nil if no version can satisfy it.
+// For example, the lowest version satisfying `>1.2.3 || ^2.0.0` is `1.2.4`.
+func (c *Constraint) MinVersion() *Version {
+
+	// the lowest version satisfying a comparator set is usually its lower bound, or the version just above it or just
+	// above a version excluded by `!=`. Those are tried for every set, lowest first, along with the lowest release and
+	// prerelease for sets without a lower bound.
+	candidates := Slice{new(Version), lowestPrerelease(new(Version))}
+
+	above := func(v *Version) {
+		if len(v.Prerelease) == 0 {
+			candidates = append(candidates, v.nextCore(2))
+		}
+		candidates = append(candidates, successor(v))
+	}
+
+	for _, set := range c.sets {
+		i := intervalFromSet(set)
+
+		switch {
+		case i.lower.version == nil:
+			// covered by the initial candidates
+		case i.lower.inclusive:
+			candidates = append(candidates, i.lower.version)
+		default:
+			above(i.lower.version)
+		}
+
+		for _, v := range i.excluded {
+			above(v)
+		}
+	}
+
+	sort.Sort(candidates)
+
+	for _, x := range candidates {
+		if c.Check(x) {
+			v := x.core()
+			v.Prerelease = x.Prerelease
+			return v
+		}
+	}
+
+	return nil
+}
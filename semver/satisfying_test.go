@@ -0,0 +1,122 @@
+package semver
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMaxSatisfying(t *testing.T) {
+	tests := []struct {
+		filter  string
+		want    string
+		wantErr bool
+	}{
+		{filter: "^2.0.0", want: "2.4.2"},
+		{filter: "~0.5.0-rc.1", want: "0.5.2"},
+		{filter: "<1.0.0 || 1.0.0-rc.1 - 1.0.0-rc.3", want: "1.0.0-rc.3"},
+		{filter: "*", want: "4.17.21"},
+		{filter: "^10.0.0"},
+		{filter: "z1.0.0", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			options := ft("").options
+			original := append(Slice(nil), options...)
+
+			got, err := MaxSatisfying(tt.filter, options)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("MaxSatisfying() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if (got == nil && tt.want != "") || (got != nil && got.String() != tt.want) {
+				t.Errorf("MaxSatisfying() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(options, original) {
+				t.Errorf("MaxSatisfying() modified options")
+			}
+		})
+	}
+}
+
+func TestMinSatisfying(t *testing.T) {
+	tests := []struct {
+		filter  string
+		want    string
+		wantErr bool
+	}{
+		{filter: "^2.0.0", want: "2.0.0"},
+		{filter: ">0.5.0-rc.1", want: "0.5.0"},
+		{filter: ">=1.0.0-rc.2", want: "1.0.0-rc.2"},
+		{filter: "*", want: "0.0.1"},
+		{filter: "^10.0.0"},
+		{filter: "z1.0.0", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			options := ft("").options
+			original := append(Slice(nil), options...)
+
+			got, err := MinSatisfying(tt.filter, options)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("MinSatisfying() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if (got == nil && tt.want != "") || (got != nil && got.String() != tt.want) {
+				t.Errorf("MinSatisfying() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(options, original) {
+				t.Errorf("MinSatisfying() modified options")
+			}
+		})
+	}
+}
+
+func TestMinVersion(t *testing.T) {
+	tests := []struct {
+		filter  string
+		want    string
+		wantErr bool
+	}{
+		// most test cases taken from https://github.com/npm/node-semver/blob/main/test/fixtures/min-version.js
+		{filter: "*", want: "0.0.0"},
+		{filter: "1.0.0", want: "1.0.0"},
+		{filter: "1.0", want: "1.0.0"},
+		{filter: "1.0.x", want: "1.0.0"},
+		{filter: "^1.2.3", want: "1.2.3"},
+		{filter: "^1.2.3-pre", want: "1.2.3-pre"},
+		{filter: "~1.1.1-pre", want: "1.1.1-pre"},
+		{filter: ">1.0.0", want: "1.0.1"},
+		{filter: ">1.0.0-0", want: "1.0.0-0.0"},
+		{filter: ">1.0.0-beta", want: "1.0.0-beta.0"},
+		{filter: ">2 || >1.0.0", want: "1.0.1"},
+		{filter: ">=1.0.0 <=1.0.0 || >=2.0.0", want: "1.0.0"},
+		{filter: "<1.0.0", want: "0.0.0"},
+		{filter: "<0.0.0-beta", want: "0.0.0-0"},
+		{filter: ">4 || <=2 || >=3.0.0 <3.1.0", want: "0.0.0"},
+		{filter: "^2.0.0 || ^1.2.3", want: "1.2.3"},
+		{filter: "1.0.0 - 2.0.0", want: "1.0.0"},
+		{filter: ">=1.0.0 !=1.0.0", want: "1.0.1"},
+		{filter: "!=0.0.0", want: "0.0.1"},
+		{filter: ">1.0.18446744073709551616", want: "1.0.18446744073709551617"},
+		{filter: ">=18446744073709551616.0.0-rc", want: "18446744073709551616.0.0-rc"},
+		{filter: ">2.0.0 <1.0.0"},
+		{filter: ">*"},
+		{filter: "z1.0.0", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			got, err := MinVersion(tt.filter)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("MinVersion() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if (got == nil && tt.want != "") || (got != nil && got.String() != tt.want) {
+				t.Errorf("MinVersion() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("IncludePrerelease", func(t *testing.T) {
+		c, _ := ParseConstraintWithOptions(">1.0.0", ConstraintOptions{IncludePrerelease: true})
+		if got, want := c.MinVersion().String(), "1.0.1-0"; got != want {
+			t.Errorf("Constraint.MinVersion() = %v, want %v", got, want)
+		}
+	})
+}
@@ -2,19 +2,39 @@ package semver
 
 import (
 	"fmt"
-	"strconv"
 	"strings"
 )
 
+// isStringNumeric returns true if s is a numeric identifier, which can be any number of digits.
 func isStringNumeric(s string) bool {
-	_, err := strconv.Atoi(s)
-	return err == nil
+	if s == "" {
+		return false
+	}
+	for _, char := range s {
+		if !isDigit(char) {
+			return false
+		}
+	}
+	return true
 }
 
+// Version is a semantic version. A major, minor or patch version that is too large to fit in an int is set to the
+// largest possible int, with its digits held in an unexported field, so two versions with the same exported fields
+// aren't necessarily the same version - use Equal or CompareTo to compare them rather than reflect.DeepEqual.
 type Version struct {
 	Major, Minor, Patch int
 	Prerelease, Build []string
-	Stable bool
+
+	// large holds the digits of any of the major, minor and patch versions that are too large to fit in an int, whose
+	// fields are set to maxInt, as `major.minor.patch` with the numbers that do fit left empty, eg
+	// `18446744073709551616..`. It is empty if there aren't any. It isn't a pointer so that
+	// versions parsed from the same string are still equal with reflect.DeepEqual.
+	large string
+}
+
+// Stable returns true if v is a stable release, meaning that its major version isn't zero and it isn't a prerelease.
+func (v *Version) Stable() bool {
+	return v.Major != 0 && len(v.Prerelease) == 0
 }
 
 func (v *Version) String() string {
@@ -31,6 +51,10 @@ func (v *Version) String() string {
 		build = "+" + strings.Join(v.Build, ".")
 	}
 
+	if v.large != "" {
+		return v.coreNumber(0) + "." + v.coreNumber(1) + "." + v.coreNumber(2) + prerelease + build
+	}
+
 	return fmt.Sprintf("%d.%d.%d%s%s", v.Major, v.Minor, v.Patch, prerelease, build)
 }
 
@@ -40,8 +64,10 @@ func (s Slice) Len() int {
 	return len(s)
 }
 
+// Less orders versions using Compare, so that versions which only differ in build metadata are always sorted the
+// same way.
 func (s Slice) Less(i, j int) bool {
-	return s[i].CompareTo(s[j]) == -1
+	return Compare(s[i], s[j]) < 0
 }
 
 func (s Slice) Swap(i, j int) {
@@ -31,7 +31,6 @@ func TestVersion_String(t *testing.T) {
 				Patch:      tt.fields.Patch,
 				Prerelease: tt.fields.Prerelease,
 				Build:      tt.fields.Build,
-				Stable:     tt.fields.Stable,
 			}
 			if got := v.String(); got != tt.want {
 				t.Errorf("Version.String() = %v, want %v", got, tt.want)
@@ -118,4 +117,33 @@ func TestSlice_Swap(t *testing.T) {
 	if parsed[i] != jx || parsed[j] != ix {
 		t.Fatalf("Slice.Swap() is not correctly swapping values")
 	}
-}
\ No newline at end of file
+}
+func TestVersion_Stable(t *testing.T) {
+	for x, want := range map[string]bool{"1.0.0": true, "1.0.0+build": true, "0.2.3": false, "1.0.0-rc.1": false} {
+		v := mkv(x)
+		if got := v.Stable(); got != want {
+			t.Errorf("Version(%s).Stable() = %v, want %v", x, got, want)
+		}
+
+		v.Prerelease = nil
+		if got, want := v.Stable(), v.Major != 0; got != want {
+			t.Errorf("Version(%s).Stable() after removing its prerelease = %v, want %v", x, got, want)
+		}
+	}
+}
+
+func TestSliceSortBuild(t *testing.T) {
+	want := []string{"1.0.0", "1.0.0+a", "1.0.0+b", "1.0.0+c"}
+	for _, order := range [][]string{{"1.0.0+c", "1.0.0+a", "1.0.0", "1.0.0+b"}, {"1.0.0+b", "1.0.0", "1.0.0+c", "1.0.0+a"}} {
+		parsed, _ := ParseMultiple(order)
+		sort.Sort(parsed)
+
+		var got []string
+		for _, x := range parsed {
+			got = append(got, x.String())
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Sorted slice is %v, want %v", got, want)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package semver
+
+import (
+	"sort"
+	"strings"
+)
+
+// Sort sorts s in ascending order, using Compare.
+func (s Slice) Sort() {
+	sort.Sort(s)
+}
+
+// SortDesc sorts s in descending order, using Compare.
+func (s Slice) SortDesc() {
+	sort.Sort(sort.Reverse(s))
+}
+
+// SortStable sorts s in ascending order of precedence, using CompareTo, keeping versions that only differ in build
+// metadata in the order they were in.
+func (s Slice) SortStable() {
+	sort.SliceStable(s, func(i, j int) bool {
+		return s[i].CompareTo(s[j]) < 0
+	})
+}
+
+// Dedupe returns the versions in s without any that have the same precedence as an earlier one, so `1.0.0+b` is
+// removed if `1.0.0+a` comes before it. The order of s is kept, and s isn't modified.
+func (s Slice) Dedupe() Slice {
+	return s.dedupe(func(v *Version) string {
+		return strings.SplitN(v.String(), "+", 2)[0]
+	})
+}
+
+// DedupeStrict returns the versions in s without any that are identical to an earlier one, including their build
+// metadata. The order of s is kept, and s isn't modified.
+func (s Slice) DedupeStrict() Slice {
+	return s.dedupe((*Version).String)
+}
+
+func (s Slice) dedupe(key func(v *Version) string) Slice {
+	var x Slice
+	seen := make(map[string]bool, len(s))
+	for _, v := range s {
+		k := key(v)
+		if !seen[k] {
+			seen[k] = true
+			x = append(x, v)
+		}
+	}
+	return x
+}
+
+// Latest returns the highest version in s, using Compare, or nil if s is empty.
+func (s Slice) Latest() *Version {
+	var x *Version
+	for _, v := range s {
+		if x == nil || Compare(v, x) > 0 {
+			x = v
+		}
+	}
+	return x
+}
+
+// Oldest returns the lowest version in s, using Compare, or nil if s is empty.
+func (s Slice) Oldest() *Version {
+	var x *Version
+	for _, v := range s {
+		if x == nil || Compare(v, x) < 0 {
+			x = v
+		}
+	}
+	return x
+}
+
+// GroupByMajor groups the versions in s by their major version. The versions in each group are in the same order as
+// in s. Major versions that are too large to fit in an int are all grouped under the largest possible int.
+func (s Slice) GroupByMajor() map[int]Slice {
+	x := make(map[int]Slice)
+	for _, v := range s {
+		x[v.Major] = append(x[v.Major], v)
+	}
+	return x
+}
+
+// MajorMinor is a major and minor version, used to group versions by GroupByMinor.
+type MajorMinor struct {
+	Major, Minor int
+}
+
+// GroupByMinor groups the versions in s by their major and minor version. The versions in each group are in the same
+// order as in s. As with GroupByMajor, numbers that are too large to fit in an int are grouped under the largest
+// possible int.
+func (s Slice) GroupByMinor() map[MajorMinor]Slice {
+	x := make(map[MajorMinor]Slice)
+	for _, v := range s {
+		key := MajorMinor{Major: v.Major, Minor: v.Minor}
+		x[key] = append(x[key], v)
+	}
+	return x
+}
+
+// LatestPerMajor returns the highest version with each major version in s, in ascending order.
+func (s Slice) LatestPerMajor() Slice {
+	sorted := append(Slice(nil), s...)
+	sorted.Sort()
+
+	var x Slice
+	for i, v := range sorted {
+		if i+1 == len(sorted) || compareCoreNumbers(v, sorted[i+1], 0) != 0 {
+			x = append(x, v)
+		}
+	}
+	return x
+}
+
+// Search returns the index of the first version in s that has the same or higher precedence than target, or len(s)
+// if there isn't one, which is where target would be inserted to keep s sorted. found is true if the version at the
+// index has the same precedence as target. s must be sorted in ascending order.
+func (s Slice) Search(target *Version) (index int, found bool) {
+	index = sort.Search(len(s), func(i int) bool {
+		return s[i].CompareTo(target) >= 0
+	})
+	return index, index < len(s) && s[index].CompareTo(target) == 0
+}
+
+// Floor returns the highest version in s that has the same or lower precedence than target, or nil if there isn't
+// one. s must be sorted in ascending order.
+func (s Slice) Floor(target *Version) *Version {
+	i := sort.Search(len(s), func(i int) bool {
+		return s[i].CompareTo(target) > 0
+	})
+	if i == 0 {
+		return nil
+	}
+	return s[i-1]
+}
+
+// Ceil returns the lowest version in s that has the same or higher precedence than target, or nil if there isn't
+// one. s must be sorted in ascending order.
+func (s Slice) Ceil(target *Version) *Version {
+	i, _ := s.Search(target)
+	if i == len(s) {
+		return nil
+	}
+	return s[i]
+}
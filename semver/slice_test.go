@@ -0,0 +1,165 @@
+package semver
+
+import (
+	"reflect"
+	"testing"
+)
+
+func unsorted(vers ...string) Slice {
+	x, err := ParseMultiple(vers)
+	if err != nil {
+		panic(err)
+	}
+	return x
+}
+
+func sliceStrings(s Slice) []string {
+	var x []string
+	for _, v := range s {
+		x = append(x, v.String())
+	}
+	return x
+}
+
+func TestSlice_Sort(t *testing.T) {
+	s := unsorted("1.0.0+b", "2.0.0", "1.0.0-rc.1", "1.0.0+a", "0.1.0")
+
+	s.Sort()
+	if got, want := sliceStrings(s), []string{"0.1.0", "1.0.0-rc.1", "1.0.0+a", "1.0.0+b", "2.0.0"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Slice.Sort() = %v, want %v", got, want)
+	}
+
+	s.SortDesc()
+	if got, want := sliceStrings(s), []string{"2.0.0", "1.0.0+b", "1.0.0+a", "1.0.0-rc.1", "0.1.0"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Slice.SortDesc() = %v, want %v", got, want)
+	}
+}
+
+func TestSlice_SortStable(t *testing.T) {
+	s := unsorted("1.0.0+b", "2.0.0", "1.0.0+c", "1.0.0-rc.1", "1.0.0+a")
+	s.SortStable()
+	if got, want := sliceStrings(s), []string{"1.0.0-rc.1", "1.0.0+b", "1.0.0+c", "1.0.0+a", "2.0.0"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Slice.SortStable() = %v, want %v", got, want)
+	}
+}
+
+func TestSlice_Dedupe(t *testing.T) {
+	s := unsorted("1.0.0+b", "2.0.0", "1.0.0", "1.0.0+b", "1.0.0-rc.1", "2.0.0", "1.0.0+a")
+	before := sliceStrings(s)
+
+	if got, want := sliceStrings(s.Dedupe()), []string{"1.0.0+b", "2.0.0", "1.0.0-rc.1"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Slice.Dedupe() = %v, want %v", got, want)
+	}
+	if got, want := sliceStrings(s.DedupeStrict()), []string{"1.0.0+b", "2.0.0", "1.0.0", "1.0.0-rc.1", "1.0.0+a"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Slice.DedupeStrict() = %v, want %v", got, want)
+	}
+	if got := sliceStrings(s); !reflect.DeepEqual(got, before) {
+		t.Errorf("Slice was modified to %v, want %v", got, before)
+	}
+}
+
+func TestSlice_LatestOldest(t *testing.T) {
+	s := unsorted("1.0.0", "2.0.0-rc.1", "1.0.0-rc.1", "2.0.0-rc.1+b", "0.1.0+a", "0.1.0")
+
+	if got, want := s.Latest().String(), "2.0.0-rc.1+b"; got != want {
+		t.Errorf("Slice.Latest() = %v, want %v", got, want)
+	}
+	if got, want := s.Oldest().String(), "0.1.0"; got != want {
+		t.Errorf("Slice.Oldest() = %v, want %v", got, want)
+	}
+
+	var empty Slice
+	if got := empty.Latest(); got != nil {
+		t.Errorf("Slice.Latest() = %v, want nil", got)
+	}
+	if got := empty.Oldest(); got != nil {
+		t.Errorf("Slice.Oldest() = %v, want nil", got)
+	}
+}
+
+func TestSlice_Group(t *testing.T) {
+	s := unsorted("1.2.0", "2.0.0", "1.0.0", "1.2.1-rc.1", "0.1.0", "2.0.1", "1.0.5")
+
+	byMajor := make(map[int][]string)
+	for k, v := range s.GroupByMajor() {
+		byMajor[k] = sliceStrings(v)
+	}
+	wantMajor := map[int][]string{
+		0: {"0.1.0"},
+		1: {"1.2.0", "1.0.0", "1.2.1-rc.1", "1.0.5"},
+		2: {"2.0.0", "2.0.1"},
+	}
+	if !reflect.DeepEqual(byMajor, wantMajor) {
+		t.Errorf("Slice.GroupByMajor() = %v, want %v", byMajor, wantMajor)
+	}
+
+	byMinor := make(map[MajorMinor][]string)
+	for k, v := range s.GroupByMinor() {
+		byMinor[k] = sliceStrings(v)
+	}
+	wantMinor := map[MajorMinor][]string{
+		{0, 1}: {"0.1.0"},
+		{1, 0}: {"1.0.0", "1.0.5"},
+		{1, 2}: {"1.2.0", "1.2.1-rc.1"},
+		{2, 0}: {"2.0.0", "2.0.1"},
+	}
+	if !reflect.DeepEqual(byMinor, wantMinor) {
+		t.Errorf("Slice.GroupByMinor() = %v, want %v", byMinor, wantMinor)
+	}
+
+	if got, want := sliceStrings(s.LatestPerMajor()), []string{"0.1.0", "1.2.1-rc.1", "2.0.1"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Slice.LatestPerMajor() = %v, want %v", got, want)
+	}
+
+	large := unsorted("18446744073709551617.0.0", "18446744073709551616.1.0", "18446744073709551616.0.0")
+	if got, want := sliceStrings(large.LatestPerMajor()), []string{"18446744073709551616.1.0", "18446744073709551617.0.0"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Slice.LatestPerMajor() = %v, want %v", got, want)
+	}
+}
+
+func TestSlice_Search(t *testing.T) {
+	s := unsorted("1.0.0", "1.1.0-rc.1", "1.1.0+a", "1.1.0+b", "2.0.0")
+	s.Sort()
+
+	tests := []struct {
+		target    string
+		wantIndex int
+		wantFound bool
+		wantFloor string
+		wantCeil  string
+	}{
+		{"0.1.0", 0, false, "", "1.0.0"},
+		{"1.0.0", 0, true, "1.0.0", "1.0.0"},
+		{"1.0.0+z", 0, true, "1.0.0", "1.0.0"},
+		{"1.0.1", 1, false, "1.0.0", "1.1.0-rc.1"},
+		{"1.1.0-rc.0", 1, false, "1.0.0", "1.1.0-rc.1"},
+		{"1.1.0", 2, true, "1.1.0+b", "1.1.0+a"},
+		{"1.5.0", 4, false, "1.1.0+b", "2.0.0"},
+		{"2.0.0", 4, true, "2.0.0", "2.0.0"},
+		{"3.0.0", 5, false, "2.0.0", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.target, func(t *testing.T) {
+			target := mkv(tt.target)
+
+			index, found := s.Search(target)
+			if index != tt.wantIndex || found != tt.wantFound {
+				t.Errorf("Slice.Search() = %v, %v, want %v, %v", index, found, tt.wantIndex, tt.wantFound)
+			}
+
+			var floor, ceil string
+			if v := s.Floor(target); v != nil {
+				floor = v.String()
+			}
+			if v := s.Ceil(target); v != nil {
+				ceil = v.String()
+			}
+			if floor != tt.wantFloor {
+				t.Errorf("Slice.Floor() = %q, want %q", floor, tt.wantFloor)
+			}
+			if ceil != tt.wantCeil {
+				t.Errorf("Slice.Ceil() = %q, want %q", ceil, tt.wantCeil)
+			}
+		})
+	}
+}